@@ -0,0 +1,199 @@
+// deadletter 查看和重新投递msgconsumer的死信
+//
+// 查看点赞队列中最近一天的死信：
+//
+//	deadletter -queue message_Like -since 24h
+//
+// 将失败原因包含timeout的死信重新投递到原队列：
+//
+//	deadletter -action replay -reason timeout
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/rabbitmq/amqp091-go"
+	"os"
+	"star/app/constant/str"
+	"star/app/utils/rabbitmq"
+	"strings"
+	"time"
+)
+
+// 默认处理的消息队列
+var messageQueues = []string{
+	str.MessageLike,
+	str.MessageMention,
+	str.MessageReply,
+	str.MessageSystem,
+	str.MessagePrivateMsg,
+	str.MessageGroupMsg,
+}
+
+type deadLetter struct {
+	Queue       string   `json:"queue"`
+	RoutingKey  string   `json:"routingKey"`
+	Reason      string   `json:"reason"`
+	DeadAt      string   `json:"deadAt"`
+	RetryCount  int32    `json:"retryCount"`
+	ReplayCount int32    `json:"replayCount"`
+	Attempts    []string `json:"attempts"`
+	Body        string   `json:"body"`
+	Replayed    bool     `json:"replayed,omitempty"`
+}
+
+// filter 死信的筛选条件
+type filter struct {
+	reason string
+	since  time.Duration
+}
+
+func (f *filter) match(letter *deadLetter) bool {
+	if f.reason != "" && !strings.Contains(letter.Reason, f.reason) {
+		return false
+	}
+	if f.since > 0 {
+		deadAt, err := time.Parse(time.RFC3339, letter.DeadAt)
+		if err != nil || time.Since(deadAt) > f.since {
+			return false
+		}
+	}
+	return true
+}
+
+func main() {
+	queue := flag.String("queue", "", "死信对应的消息队列，多个用逗号分隔，默认全部")
+	action := flag.String("action", "list", "list 查看死信，replay 重新投递到原队列")
+	reason := flag.String("reason", "", "只处理失败原因包含该内容的死信")
+	since := flag.Duration("since", 0, "只处理最近这段时间内进入死信队列的消息，0表示不限")
+	limit := flag.Int("limit", 100, "每个队列最多处理的死信数量")
+	flag.Parse()
+
+	if *action != "list" && *action != "replay" {
+		fmt.Fprintf(os.Stderr, "unknown action %q\n", *action)
+		os.Exit(2)
+	}
+	queues := messageQueues
+	if *queue != "" {
+		queues = strings.Split(*queue, ",")
+	}
+
+	conn, err := amqp091.Dial(rabbitmq.ReturnRabbitmqUrl())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "connect rabbitmq error:", err)
+		os.Exit(1)
+	}
+	defer conn.Close()
+
+	f := &filter{reason: *reason, since: *since}
+	encoder := json.NewEncoder(os.Stdout)
+	for _, q := range queues {
+		count, err := process(conn, strings.TrimSpace(q), f, *action == "replay", *limit, encoder)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "process %s error: %v\n", q, err)
+			continue
+		}
+		fmt.Fprintf(os.Stderr, "%s: %d dead letters matched\n", q, count)
+	}
+}
+
+// process 逐条取出死信，匹配的输出并按需重新投递，其余的在处理结束后放回死信队列
+// 处理过程中取出的死信都未确认，放回前不会被重复取到
+func process(conn *amqp091.Connection, queue string, f *filter, replay bool, limit int, encoder *json.Encoder) (int, error) {
+	// 队列不存在时通道会被关闭，每个队列使用单独的通道
+	channel, err := conn.Channel()
+	if err != nil {
+		return 0, err
+	}
+	defer channel.Close()
+	if replay {
+		if err := channel.Confirm(false); err != nil {
+			return 0, err
+		}
+	}
+
+	var pending []amqp091.Delivery
+	defer func() {
+		for _, msg := range pending {
+			msg.Nack(false, true)
+		}
+	}()
+	matched := 0
+	for matched < limit {
+		msg, ok, err := channel.Get(rabbitmq.DeadLetterQueue(queue), false)
+		if err != nil {
+			return matched, err
+		}
+		if !ok {
+			break
+		}
+		letter := parseDeadLetter(queue, msg)
+		if !f.match(letter) {
+			pending = append(pending, msg)
+			continue
+		}
+		matched++
+		if replay {
+			if err := republish(channel, msg); err != nil {
+				pending = append(pending, msg)
+				return matched, err
+			}
+			if err := msg.Ack(false); err != nil {
+				return matched, err
+			}
+			letter.Replayed = true
+		} else {
+			pending = append(pending, msg)
+		}
+		if err := encoder.Encode(letter); err != nil {
+			return matched, err
+		}
+	}
+	return matched, nil
+}
+
+func parseDeadLetter(queue string, msg amqp091.Delivery) *deadLetter {
+	letter := &deadLetter{
+		Queue:      queue,
+		RoutingKey: msg.RoutingKey,
+		RetryCount: rabbitmq.RetryCount(msg.Headers),
+		Attempts:   rabbitmq.Attempts(msg.Headers),
+		Body:       string(msg.Body),
+	}
+	letter.Reason, _ = msg.Headers[rabbitmq.HeaderDeadReason].(string)
+	letter.DeadAt, _ = msg.Headers[rabbitmq.HeaderDeadAt].(string)
+	letter.ReplayCount, _ = msg.Headers[rabbitmq.HeaderReplayCount].(int32)
+	return letter
+}
+
+// republish 将死信重新投递到消息交换机，重置重试次数并保留失败记录
+func republish(channel *amqp091.Channel, msg amqp091.Delivery) error {
+	headers := rabbitmq.CopyHeaders(msg.Headers)
+	replayCount, _ := headers[rabbitmq.HeaderReplayCount].(int32)
+	for _, key := range []string{rabbitmq.HeaderRetryCount, rabbitmq.HeaderDelay,
+		rabbitmq.HeaderDeadReason, rabbitmq.HeaderDeadQueue, rabbitmq.HeaderDeadAt} {
+		delete(headers, key)
+	}
+	headers[rabbitmq.HeaderReplayCount] = replayCount + 1
+	confirm, err := channel.PublishWithDeferredConfirmWithContext(
+		context.Background(),
+		str.MessageExchange,
+		msg.RoutingKey,
+		false,
+		false,
+		amqp091.Publishing{
+			Headers:      headers,
+			Body:         msg.Body,
+			ContentType:  msg.ContentType,
+			DeliveryMode: amqp091.Persistent,
+		})
+	if err != nil {
+		return err
+	}
+	if !confirm.Wait() {
+		return fmt.Errorf("publish to %s not confirmed", msg.RoutingKey)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/viper"
 	"log"
+	"time"
 )
 
 // Conf 定义一个全局配置变量
@@ -12,24 +13,39 @@ var Conf = new(AppConfig)
 
 // AppConfig  网站配置
 type AppConfig struct {
-	PodIpAddr       string `mapstructure:"pod_ip_addr"` //服务ip地址
-	SnowflakeId     int64  `mapstructure:"snowflake_id"`
-	*GinConfig      `mapstructure:"gin"`
-	*MysqlConfig    `mapstructure:"mysql"`
-	*RedisConfig    `mapstructure:"redis"`
-	*AliyunConfig   `mapstructure:"aliyun"`
-	*ServiceConfig  `mapstructure:"service"`
-	*EtcdConfig     `mapstructure:"etcd"`
-	*RabbitMQConfig `mapstructure:"rabbitmq"`
-	*QiniuConfig    `mapstructure:"qiniuyv"`
-	*LogConfig      `mapstructure:"log"`
-	*TracerConfig   `mapstructure:"tracer"`
-	*Admin          `mapstructure:"admin"`
+	PodIpAddr        string `mapstructure:"pod_ip_addr"` //服务ip地址
+	SnowflakeId      int64  `mapstructure:"snowflake_id"`
+	*GinConfig       `mapstructure:"gin"`
+	*MysqlConfig     `mapstructure:"mysql"`
+	*RedisConfig     `mapstructure:"redis"`
+	*AliyunConfig    `mapstructure:"aliyun"`
+	*ServiceConfig   `mapstructure:"service"`
+	*EtcdConfig      `mapstructure:"etcd"`
+	*RabbitMQConfig  `mapstructure:"rabbitmq"`
+	*QiniuConfig     `mapstructure:"qiniuyv"`
+	*LogConfig       `mapstructure:"log"`
+	*TracerConfig    `mapstructure:"tracer"`
+	*Admin           `mapstructure:"admin"`
+	*FeedConfig      `mapstructure:"feed"`
+	*StorageConfig   `mapstructure:"storage"`
+	*WsConfig        `mapstructure:"websocket"`
+	*FavorConfig     `mapstructure:"favor"`
+	*JwtConfig       `mapstructure:"jwt"`
+	*RateLimitConfig `mapstructure:"rate_limit"`
 }
 
 type GinConfig struct {
-	HttpHost string `mapstructure:"http_host"`
-	HttpPort int    `mapstructure:"http_port"`
+	HttpHost       string   `mapstructure:"http_host"`
+	HttpPort       int      `mapstructure:"http_port"`
+	TrustedProxies []string `mapstructure:"trusted_proxies"` //可信的反向代理ip或网段，为空时不信任X-Forwarded-For
+}
+
+// GetTrustedProxies 获取可信的反向代理，未配置时返回nil
+func (g *GinConfig) GetTrustedProxies() []string {
+	if g == nil || len(g.TrustedProxies) == 0 {
+		return nil
+	}
+	return g.TrustedProxies
 }
 
 // MysqlConfig  mysql配置
@@ -85,6 +101,22 @@ type QiniuConfig struct {
 	SecretKey   string `mapstructure:"secret_key"`
 	Bucket      string `mapstructure:"bucket"`
 	QiniuServer string `mapstructure:"qiniuServer"`
+	Zone        string `mapstructure:"zone"` //存储区域，如z0(华东)、z1(华北)、z2(华南)
+}
+
+// StorageConfig 对象存储配置
+type StorageConfig struct {
+	Backend      string `mapstructure:"backend"`        //qiniu、local、s3,默认为qiniu
+	LocalDir     string `mapstructure:"local_dir"`      //本地存储根目录
+	LocalBaseUrl string `mapstructure:"local_base_url"` //本地存储访问地址
+	LocalSignKey string `mapstructure:"local_sign_key"` //本地存储签名链接密钥
+	S3Endpoint   string `mapstructure:"s3_endpoint"`
+	S3Region     string `mapstructure:"s3_region"`
+	S3Bucket     string `mapstructure:"s3_bucket"`
+	S3AccessKey  string `mapstructure:"s3_access_key"`
+	S3SecretKey  string `mapstructure:"s3_secret_key"`
+	S3PathStyle  bool   `mapstructure:"s3_path_style"` //使用路径形式访问bucket,minio等需要开启
+	S3PublicUrl  string `mapstructure:"s3_public_url"` //公开访问地址，为空时使用endpoint
 }
 
 // LogConfig 日志配置
@@ -110,6 +142,142 @@ type Admin struct {
 	Password string `mapstructure:"password"`
 }
 
+// FeedConfig 关注流配置
+type FeedConfig struct {
+	FanOutThreshold int64 `mapstructure:"fan_out_threshold"` //粉丝数超过该值的用户发帖不再推送，由粉丝读取时拉取
+	TimelineLength  int64 `mapstructure:"timeline_length"`   //每个用户收件箱的最大长度
+}
+
+// GetFanOutThreshold 获取推送阈值，未配置时使用默认值
+func (f *FeedConfig) GetFanOutThreshold() int64 {
+	if f == nil || f.FanOutThreshold <= 0 {
+		return 5000
+	}
+	return f.FanOutThreshold
+}
+
+// GetTimelineLength 获取收件箱长度，未配置时使用默认值
+func (f *FeedConfig) GetTimelineLength() int64 {
+	if f == nil || f.TimelineLength <= 0 {
+		return 800
+	}
+	return f.TimelineLength
+}
+
+// WsConfig 消息服务websocket配置
+type WsConfig struct {
+	WsHost string `mapstructure:"host"`
+	WsPort int    `mapstructure:"port"`
+}
+
+// GetWsAddr 获取websocket监听地址，未配置时使用默认端口
+func (w *WsConfig) GetWsAddr() string {
+	if w == nil || w.WsPort <= 0 {
+		return ":8081"
+	}
+	return fmt.Sprintf("%s:%d", w.WsHost, w.WsPort)
+}
+
+// FavorConfig 点赞、收藏计数聚合配置
+type FavorConfig struct {
+	FlushInterval int    `mapstructure:"flush_interval"` //写入数据库的间隔，单位秒
+	BatchSize     int    `mapstructure:"batch_size"`     //每批最多合并的消息数，达到后立即写入
+	MetricsAddr   string `mapstructure:"metrics_addr"`   //写入延迟等指标的监听地址
+	AutoRepair    bool   `mapstructure:"auto_repair"`    //定时对账是否自动修复偏差，默认只报告
+}
+
+// GetFlushInterval 获取写入间隔，未配置时使用默认值
+func (f *FavorConfig) GetFlushInterval() time.Duration {
+	if f == nil || f.FlushInterval <= 0 {
+		return 10 * time.Second
+	}
+	return time.Duration(f.FlushInterval) * time.Second
+}
+
+// GetBatchSize 获取批次大小，未配置时使用默认值
+func (f *FavorConfig) GetBatchSize() int {
+	if f == nil || f.BatchSize <= 0 {
+		return 500
+	}
+	return f.BatchSize
+}
+
+// GetMetricsAddr 获取指标监听地址，未配置时使用默认端口
+func (f *FavorConfig) GetMetricsAddr() string {
+	if f == nil || f.MetricsAddr == "" {
+		return ":9101"
+	}
+	return f.MetricsAddr
+}
+
+// GetAutoRepair 定时对账是否自动修复计数，未配置时只报告偏差
+func (f *FavorConfig) GetAutoRepair() bool {
+	return f != nil && f.AutoRepair
+}
+
+// JwtConfig token签名配置，轮换密钥时先添加新密钥并发布网关，再修改signing_kid，旧密钥在refresh token过期后删除
+type JwtConfig struct {
+	SigningKid string   `mapstructure:"signing_kid"` //签发token使用的密钥
+	Keys       []JwtKey `mapstructure:"keys"`        //所有用于验证token的密钥
+}
+
+// JwtKey 签名密钥，只验证token的服务可以只配置公钥
+type JwtKey struct {
+	Kid            string `mapstructure:"kid"`
+	Alg            string `mapstructure:"alg"`              //RS256或EdDSA
+	PrivateKey     string `mapstructure:"private_key"`      //PEM格式的私钥
+	PrivateKeyFile string `mapstructure:"private_key_file"` //私钥文件，private_key为空时读取
+	PublicKey      string `mapstructure:"public_key"`       //PEM格式的公钥，为空时由私钥得到
+	PublicKeyFile  string `mapstructure:"public_key_file"`
+}
+
+// RateLimitConfig 网关限流配置
+type RateLimitConfig struct {
+	Policies     map[string]*RateLimitPolicy `mapstructure:"policies"`      //按名称覆盖代码中的默认限流策略
+	BanThreshold int                         `mapstructure:"ban_threshold"` //时间窗口内被限流的次数达到后封禁ip
+	BanWindow    int                         `mapstructure:"ban_window"`    //统计被限流次数的时间窗口，单位秒
+	BanDuration  int                         `mapstructure:"ban_duration"`  //封禁时间，单位秒
+}
+
+// RateLimitPolicy 限流策略，每个周期允许rate次请求，最多可以突发burst次
+type RateLimitPolicy struct {
+	Rate   int `mapstructure:"rate"`
+	Burst  int `mapstructure:"burst"`
+	Period int `mapstructure:"period"` //周期，单位秒
+}
+
+// GetPolicy 获取配置的限流策略，未配置时返回nil
+func (r *RateLimitConfig) GetPolicy(name string) *RateLimitPolicy {
+	if r == nil {
+		return nil
+	}
+	return r.Policies[name]
+}
+
+// GetBanThreshold 获取封禁阈值，未配置时使用默认值
+func (r *RateLimitConfig) GetBanThreshold() int64 {
+	if r == nil || r.BanThreshold <= 0 {
+		return 20
+	}
+	return int64(r.BanThreshold)
+}
+
+// GetBanWindow 获取统计被限流次数的时间窗口，未配置时使用默认值
+func (r *RateLimitConfig) GetBanWindow() time.Duration {
+	if r == nil || r.BanWindow <= 0 {
+		return 10 * time.Minute
+	}
+	return time.Duration(r.BanWindow) * time.Second
+}
+
+// GetBanDuration 获取封禁时间，未配置时使用默认值
+func (r *RateLimitConfig) GetBanDuration() time.Duration {
+	if r == nil || r.BanDuration <= 0 {
+		return time.Hour
+	}
+	return time.Duration(r.BanDuration) * time.Second
+}
+
 func init() {
 	//设置读取配置文件路径
 	viper.SetConfigFile("C:\\Users\\浅梦\\Desktop\\star\\app\\constant\\settings\\config.yaml")
@@ -36,6 +36,23 @@ const (
 	AutoLoginErrorCode
 	CategoryNotExistsCode
 	CategoryIdExistsCode
+	NotPostAuthorCode
+	UploadNotExistsCode
+	ChunkIncompleteCode
+	InvalidImageCode
+	ImageTooLargeCode
+	NotCommunityMemberCode
+	InvalidMessageCode
+	NoticeNotExistsCode
+	InvalidNoticeCode
+	NotCommentAuthorCode
+	InvalidRefreshTokenCode
+	SessionRevokedCode
+	SessionNotExistsCode
+	PermissionDeniedCode
+	RoleNotExistsCode
+	UserRoleNotExistsCode
+	IPBannedCode
 )
 
 const (
@@ -53,6 +70,7 @@ const (
 	PublishErrorCode
 	FeedErrorCode
 	CategoryErrorCode
+	RoleErrorCode
 )
 
 var (
@@ -85,6 +103,23 @@ var (
 	ErrAutoLoginError       = errors.New("自动登录失败")
 	ErrCategoryNotExists    = errors.New("分类不存在")
 	ErrCategoryIdExists     = errors.New("分类编号已存在")
+	ErrNotPostAuthor        = errors.New("只能修改或删除自己的帖子")
+	ErrUploadNotExists      = errors.New("上传任务不存在或已过期")
+	ErrChunkIncomplete      = errors.New("视频分片未上传完整")
+	ErrInvalidImage         = errors.New("仅支持jpg、png、gif、webp格式的图片")
+	ErrImageTooLarge        = errors.New("图片大小或尺寸超出限制")
+	ErrNotCommunityMember   = errors.New("请先关注该社区")
+	ErrInvalidMessage       = errors.New("消息不能为空且不能超过255个字")
+	ErrNoticeNotExists      = errors.New("系统通知不存在或已撤回")
+	ErrInvalidNotice        = errors.New("系统通知的标题、内容或发送对象不正确")
+	ErrNotCommentAuthor     = errors.New("只能删除自己的评论")
+	ErrInvalidRefreshToken  = errors.New("非法的refreshToken")
+	ErrSessionRevoked       = errors.New("登录已失效，请重新登录")
+	ErrSessionNotExists     = errors.New("登录设备不存在")
+	ErrPermissionDenied     = errors.New("没有权限")
+	ErrRoleNotExists        = errors.New("角色不存在或不能授予")
+	ErrUserRoleNotExists    = errors.New("用户没有该角色")
+	ErrIPBanned             = errors.New("请求过于频繁，已被暂时限制访问")
 )
 
 var (
@@ -102,6 +137,7 @@ var (
 	ErrCollectError   = errors.New("收藏服务内部出现问题，请稍后再试！")
 	ErrPublishError   = errors.New("发帖服务内部出现问题，请稍后再试！")
 	ErrCategoryError  = errors.New("分类服务内部出现问题，请稍后再试! ")
+	ErrRoleError      = errors.New("权限服务内部出现问题，请稍后再试！")
 )
 
 var codeMap = map[error]int32{
@@ -134,6 +170,23 @@ var codeMap = map[error]int32{
 	ErrAutoLoginError:       AutoLoginErrorCode,
 	ErrCategoryNotExists:    CategoryNotExistsCode,
 	ErrCategoryIdExists:     CategoryIdExistsCode,
+	ErrNotPostAuthor:        NotPostAuthorCode,
+	ErrUploadNotExists:      UploadNotExistsCode,
+	ErrChunkIncomplete:      ChunkIncompleteCode,
+	ErrInvalidImage:         InvalidImageCode,
+	ErrImageTooLarge:        ImageTooLargeCode,
+	ErrNotCommunityMember:   NotCommunityMemberCode,
+	ErrInvalidMessage:       InvalidMessageCode,
+	ErrNoticeNotExists:      NoticeNotExistsCode,
+	ErrInvalidNotice:        InvalidNoticeCode,
+	ErrNotCommentAuthor:     NotCommentAuthorCode,
+	ErrInvalidRefreshToken:  InvalidRefreshTokenCode,
+	ErrSessionRevoked:       SessionRevokedCode,
+	ErrSessionNotExists:     SessionNotExistsCode,
+	ErrPermissionDenied:     PermissionDeniedCode,
+	ErrRoleNotExists:        RoleNotExistsCode,
+	ErrUserRoleNotExists:    UserRoleNotExistsCode,
+	ErrIPBanned:             IPBannedCode,
 
 	ErrServiceBusy:    ServiceBusyCode,
 	ErrUserError:      UserErrorCode,
@@ -149,6 +202,7 @@ var codeMap = map[error]int32{
 	ErrCollectError:   CollectErrorCode,
 	ErrPublishError:   PublishErrorCode,
 	ErrCategoryError:  CategoryErrorCode,
+	ErrRoleError:      RoleErrorCode,
 }
 
 func getCode(err error) int32 {
@@ -160,6 +214,11 @@ func getCode(err error) int32 {
 }
 
 func Response(c *gin.Context, err error, dataFields map[string]interface{}) {
+	ResponseStatus(c, http.StatusOK, err, dataFields)
+}
+
+// ResponseStatus 使用指定的http状态码返回响应，用于限流等需要非200状态码的场景
+func ResponseStatus(c *gin.Context, httpStatus int, err error, dataFields map[string]interface{}) {
 	statusCode := SuccessCode
 	statusMsg := Success
 	if err != nil {
@@ -180,6 +239,5 @@ func Response(c *gin.Context, err error, dataFields map[string]interface{}) {
 		}
 	}
 
-	c.JSON(http.StatusOK, response)
-	return
+	c.JSON(httpStatus, response)
 }
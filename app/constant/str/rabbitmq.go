@@ -6,6 +6,8 @@ const (
 	MessageExchange = "message_exchange"
 	RetryExchange   = "retry_exchange"
 	FavorExchange   = "favor_exchange"
+	//DeadLetterExchange 超过重试次数或无法解析的消息投递到死信交换机，按原路由键进入对应的死信队列
+	DeadLetterExchange = "dead_letter_exchange"
 )
 
 //Queue
@@ -16,6 +18,7 @@ const (
 	MessagePrivateMsg = "message_Private_Msg"
 	MessageReply      = "message_Reply"
 	MessageSystem     = "message_System"
+	MessageGroupMsg   = "message_Group_Msg"
 	LikePost          = "like_post"
 	LikeComment       = "like_comment"
 	CollectPost       = "collect_post"
@@ -29,6 +32,7 @@ const (
 	RoutPrivateMsg  = "message.private_Msg"
 	RoutReply       = "message.reply"
 	RoutSystem      = "message.system"
+	RoutGroupMsg    = "message.group_Msg"
 	RoutPost        = "like.feed"
 	RoutComment     = "like.comment"
 	RoutCollectPost = "collect.feed"
@@ -30,4 +30,7 @@ const (
 
 	AdminServiceClient = "AdminService.client"
 	AdminService       = "AdminService"
+
+	MsgConsumer   = "MsgConsumer"
+	FavorConsumer = "FavorConsumer"
 )
@@ -20,3 +20,19 @@ func SaveCategory(ctx context.Context, req *adminPb.SaveCategoryRequest) (*admin
 func ChangeSort(ctx context.Context, req *adminPb.ChangeSortRequest) (*adminPb.ChangeSortResponse, error) {
 	return adminService.ChangeSort(ctx, req)
 }
+
+func CheckPermission(ctx context.Context, req *adminPb.CheckPermissionRequest) (*adminPb.CheckPermissionResponse, error) {
+	return adminService.CheckPermission(ctx, req)
+}
+
+func GrantRole(ctx context.Context, req *adminPb.GrantRoleRequest) (*adminPb.GrantRoleResponse, error) {
+	return adminService.GrantRole(ctx, req)
+}
+
+func RevokeRole(ctx context.Context, req *adminPb.RevokeRoleRequest) (*adminPb.RevokeRoleResponse, error) {
+	return adminService.RevokeRole(ctx, req)
+}
+
+func ListUserRoles(ctx context.Context, req *adminPb.ListUserRolesRequest) (*adminPb.ListUserRolesResponse, error) {
+	return adminService.ListUserRoles(ctx, req)
+}
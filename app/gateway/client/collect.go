@@ -12,3 +12,15 @@ func CollectList(ctx context.Context, req *collectPb.CollectListRequest) (*colle
 func CollectAction(ctx context.Context, req *collectPb.CollectActionRequest) (*collectPb.CollectActionResponse, error) {
 	return collectService.CollectAction(ctx, req)
 }
+
+func IsCollect(ctx context.Context, req *collectPb.IsCollectRequest) (*collectPb.IsCollectResponse, error) {
+	return collectService.IsCollect(ctx, req)
+}
+
+func GetCollectCount(ctx context.Context, req *collectPb.GetCollectCountRequest) (*collectPb.GetCollectCountResponse, error) {
+	return collectService.GetCollectCount(ctx, req)
+}
+
+func GetUserCollectCount(ctx context.Context, req *collectPb.GetUserCollectCountRequest) (*collectPb.GetUserCollectCountResponse, error) {
+	return collectService.GetUserCollectCount(ctx, req)
+}
@@ -16,3 +16,11 @@ func DeleteComment(ctx context.Context, req *commentPb.DeleteCommentRequest) (*c
 func GetComments(ctx context.Context, req *commentPb.GetCommentsRequest) (*commentPb.GetCommentsResponse, error) {
 	return commentService.GetComments(ctx, req)
 }
+
+func GetReplies(ctx context.Context, req *commentPb.GetRepliesRequest) (*commentPb.GetRepliesResponse, error) {
+	return commentService.GetReplies(ctx, req)
+}
+
+func CountComment(ctx context.Context, req *commentPb.CountCommentRequest) (*commentPb.CountCommentResponse, error) {
+	return commentService.CountComment(ctx, req)
+}
@@ -25,3 +25,11 @@ func FollowCommunity(ctx context.Context, req *communityPb.FollowCommunityReques
 func UnFollowCommunity(ctx context.Context, req *communityPb.UnFollowCommunityRequest) (*communityPb.UnFollowCommunityResponse, error) {
 	return communityService.UnFollowCommunity(ctx, req)
 }
+
+func IsFollowCommunity(ctx context.Context, req *communityPb.IsFollowCommunityRequest) (*communityPb.IsFollowCommunityResponse, error) {
+	return communityService.IsFollowCommunity(ctx, req)
+}
+
+func CountCommunityFollow(ctx context.Context, req *communityPb.CountCommunityFollowRequest) (*communityPb.CountCommunityFollowResponse, error) {
+	return communityService.CountCommunityFollow(ctx, req)
+}
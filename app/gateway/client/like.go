@@ -11,3 +11,22 @@ func LikeAction(ctx context.Context, req *likePb.LikeActionRequest) (*likePb.Lik
 func LikeList(ctx context.Context, req *likePb.LikeListRequest) (*likePb.LikeListResponse, error) {
 	return likeService.LikeList(ctx, req)
 }
+func ReconcileFavorCount(ctx context.Context, req *likePb.ReconcileFavorCountRequest) (*likePb.ReconcileFavorCountResponse, error) {
+	return likeService.ReconcileFavorCount(ctx, req)
+}
+
+func GetUserTotalLike(ctx context.Context, req *likePb.GetUserTotalLikeRequest) (*likePb.GetUserTotalLikeResponse, error) {
+	return likeService.GetUserTotalLike(ctx, req)
+}
+
+func GetLikeCount(ctx context.Context, req *likePb.GetLikeCountRequest) (*likePb.GetLikeCountResponse, error) {
+	return likeService.GetLikeCount(ctx, req)
+}
+
+func IsLike(ctx context.Context, req *likePb.IsLikeRequest) (*likePb.IsLikeResponse, error) {
+	return likeService.IsLike(ctx, req)
+}
+
+func GetUserLikeCount(ctx context.Context, req *likePb.GetUserLikeCountRequest) (*likePb.GetUserLikeCountResponse, error) {
+	return likeService.GetUserLikeCount(ctx, req)
+}
@@ -28,3 +28,43 @@ func GetChatList(ctx context.Context, req *messagePb.GetChatListRequest) (*messa
 func LoadMessage(ctx context.Context, req *messagePb.LoadMessageRequest) (*messagePb.LoadMessageResponse, error) {
 	return messageService.LoadMessage(ctx, req)
 }
+
+func SendGroupMessage(ctx context.Context, req *messagePb.SendGroupMessageRequest) (*messagePb.SendGroupMessageResponse, error) {
+	return messageService.SendGroupMessage(ctx, req)
+}
+
+func LoadGroupMessage(ctx context.Context, req *messagePb.LoadGroupMessageRequest) (*messagePb.LoadGroupMessageResponse, error) {
+	return messageService.LoadGroupMessage(ctx, req)
+}
+
+func GetGroupUnreadCount(ctx context.Context, req *messagePb.GetGroupUnreadCountRequest) (*messagePb.GetGroupUnreadCountResponse, error) {
+	return messageService.GetGroupUnreadCount(ctx, req)
+}
+
+func MarkGroupRead(ctx context.Context, req *messagePb.MarkGroupReadRequest) (*messagePb.MarkGroupReadResponse, error) {
+	return messageService.MarkGroupRead(ctx, req)
+}
+
+func MarkChatRead(ctx context.Context, req *messagePb.MarkChatReadRequest) (*messagePb.MarkChatReadResponse, error) {
+	return messageService.MarkChatRead(ctx, req)
+}
+
+func MarkRemindRead(ctx context.Context, req *messagePb.MarkRemindReadRequest) (*messagePb.MarkRemindReadResponse, error) {
+	return messageService.MarkRemindRead(ctx, req)
+}
+
+func MarkAllRead(ctx context.Context, req *messagePb.MarkAllReadRequest) (*messagePb.MarkAllReadResponse, error) {
+	return messageService.MarkAllRead(ctx, req)
+}
+
+func ListRemindMessages(ctx context.Context, req *messagePb.ListRemindMessagesRequest) (*messagePb.ListRemindMessagesResponse, error) {
+	return messageService.ListRemindMessages(ctx, req)
+}
+
+func ListSystemMessages(ctx context.Context, req *messagePb.ListSystemMessagesRequest) (*messagePb.ListSystemMessagesResponse, error) {
+	return messageService.ListSystemMessages(ctx, req)
+}
+
+func RevokeSystemMessage(ctx context.Context, req *messagePb.RevokeSystemMessageRequest) (*messagePb.RevokeSystemMessageResponse, error) {
+	return messageService.RevokeSystemMessage(ctx, req)
+}
@@ -12,3 +12,27 @@ func CreatePost(ctx context.Context, req *publishPb.CreatePostRequest) (*publish
 func PreUploadVideos(ctx context.Context, req *publishPb.PreUploadVideosRequest) (*publishPb.PreUploadVideosResponse, error) {
 	return publishService.PreUploadVideos(ctx, req)
 }
+
+func UpdatePost(ctx context.Context, req *publishPb.UpdatePostRequest) (*publishPb.UpdatePostResponse, error) {
+	return publishService.UpdatePost(ctx, req)
+}
+
+func DeletePost(ctx context.Context, req *publishPb.DeletePostRequest) (*publishPb.DeletePostResponse, error) {
+	return publishService.DeletePost(ctx, req)
+}
+
+func UploadVideoChunk(ctx context.Context, req *publishPb.UploadVideoChunkRequest) (*publishPb.UploadVideoChunkResponse, error) {
+	return publishService.UploadVideoChunk(ctx, req)
+}
+
+func CompleteVideoUpload(ctx context.Context, req *publishPb.CompleteVideoUploadRequest) (*publishPb.CompleteVideoUploadResponse, error) {
+	return publishService.CompleteVideoUpload(ctx, req)
+}
+
+func CountPost(ctx context.Context, req *publishPb.CountPostRequest) (*publishPb.CountPostResponse, error) {
+	return publishService.CountPost(ctx, req)
+}
+
+func ListPost(ctx context.Context, req *publishPb.ListPostRequest) (*publishPb.ListPostResponse, error) {
+	return publishService.ListPost(ctx, req)
+}
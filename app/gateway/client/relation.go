@@ -20,3 +20,15 @@ func UnFollow(ctx context.Context, req *relationPb.UnFollowRequest) (*relationPb
 func GetFansList(ctx context.Context, req *relationPb.GetFansListRequest) (*relationPb.GetFansListResponse, error) {
 	return relationService.GetFansList(ctx, req)
 }
+
+func CountFollow(ctx context.Context, req *relationPb.CountFollowRequest) (*relationPb.CountFollowResponse, error) {
+	return relationService.CountFollow(ctx, req)
+}
+
+func CountFans(ctx context.Context, req *relationPb.CountFansRequest) (*relationPb.CountFansResponse, error) {
+	return relationService.CountFans(ctx, req)
+}
+
+func IsFollow(ctx context.Context, req *relationPb.IsFollowRequest) (*relationPb.IsFollowResponse, error) {
+	return relationService.IsFollow(ctx, req)
+}
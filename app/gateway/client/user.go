@@ -21,3 +21,19 @@ func Signup(ctx context.Context, in *userPb.LSRequest) (*userPb.EmptyLSResponse,
 func GetUserInfo(ctx context.Context, in *userPb.GetUserInfoRequest) (*userPb.GetUserInfoResponse, error) {
 	return userService.GetUserInfo(ctx, in)
 }
+
+func RefreshToken(ctx context.Context, in *userPb.RefreshTokenRequest) (*userPb.RefreshTokenResponse, error) {
+	return userService.RefreshToken(ctx, in)
+}
+
+func Logout(ctx context.Context, in *userPb.LogoutRequest) (*userPb.LogoutResponse, error) {
+	return userService.Logout(ctx, in)
+}
+
+func LogoutAllDevices(ctx context.Context, in *userPb.LogoutAllDevicesRequest) (*userPb.LogoutResponse, error) {
+	return userService.LogoutAllDevices(ctx, in)
+}
+
+func ListSessions(ctx context.Context, in *userPb.ListSessionsRequest) (*userPb.ListSessionsResponse, error) {
+	return userService.ListSessions(ctx, in)
+}
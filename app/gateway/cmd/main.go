@@ -11,12 +11,19 @@ import (
 	"star/app/extra/tracing"
 	"star/app/gateway/client"
 	"star/app/gateway/router"
+	"star/app/storage/file"
 	"star/app/utils/logging"
 )
 
 func main() {
 	//初始化微服务客户端
 	client.Init()
+	//初始化文件存储
+	if err := file.Init(); err != nil {
+		logging.Logger.Error("init file storage error",
+			zap.Error(err))
+		return
+	}
 	etcdReg := etcd.NewRegistry(
 		registry.Addrs(fmt.Sprintf("%s:%d", settings.Conf.EtcdHost, settings.Conf.EtcdPort)))
 	tp, err := tracing.SetTraceProvider("HttpService")
@@ -8,10 +8,11 @@ import (
 	"star/app/extra/tracing"
 	"star/app/gateway/client"
 	"star/app/gateway/models"
-	models2 "star/app/models"
 	"star/app/utils/jwt"
 	"star/app/utils/logging"
+	"star/app/utils/request"
 	"star/proto/admin/adminPb"
+	"strconv"
 )
 
 func LoginAdminHandler(c *gin.Context) {
@@ -41,11 +42,12 @@ func LoginAdminHandler(c *gin.Context) {
 		str.Response(c, str.ErrInvalidPassword, nil)
 		return
 	}
-	token, _, err := jwt.GetToken(&models2.User{UserId: settings.Conf.Admin.Id})
+	token, err := jwt.GetAdminToken(settings.Conf.Admin.Id)
 	if err != nil {
 		logger.Error("adminPb get token error",
 			zap.Error(err))
 		str.Response(c, err, nil)
+		return
 	}
 	str.Response(c, nil, map[string]interface{}{
 		"token": token,
@@ -62,6 +64,7 @@ func LoadCategoryListHandler(c *gin.Context) {
 	if err != nil {
 		logger.Error("load adminPb list error",
 			zap.Error(err))
+		str.Response(c, err, nil)
 		return
 	}
 	str.Response(c, nil, map[string]interface{}{
@@ -151,3 +154,104 @@ func ChangeSortHandler(c *gin.Context) {
 
 	str.Response(c, nil, nil)
 }
+
+// GrantRoleHandler 授予角色，操作者能否授予由管理服务判断
+func GrantRoleHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "GrantRoleHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.GrantRole")
+
+	role := new(models.UserRole)
+	if err := c.ShouldBindJSON(role); err != nil {
+		logger.Error("grant role error because invalid param",
+			zap.Error(err))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	actorId, err := request.GetUserId(c)
+	if err != nil {
+		str.Response(c, err, nil)
+		return
+	}
+	if _, err := client.GrantRole(c.Request.Context(), &adminPb.GrantRoleRequest{
+		ActorId:     actorId,
+		UserId:      role.UserId,
+		RoleCode:    role.RoleCode,
+		CommunityId: role.CommunityId,
+	}); err != nil {
+		logger.Error("grant role error",
+			zap.Error(err),
+			zap.Int64("userId", role.UserId),
+			zap.String("roleCode", role.RoleCode))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, nil)
+}
+
+// RevokeRoleHandler 撤销角色
+func RevokeRoleHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "RevokeRoleHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.RevokeRole")
+
+	role := new(models.UserRole)
+	if err := c.ShouldBindJSON(role); err != nil {
+		logger.Error("revoke role error because invalid param",
+			zap.Error(err))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	actorId, err := request.GetUserId(c)
+	if err != nil {
+		str.Response(c, err, nil)
+		return
+	}
+	if _, err := client.RevokeRole(c.Request.Context(), &adminPb.RevokeRoleRequest{
+		ActorId:     actorId,
+		UserId:      role.UserId,
+		RoleCode:    role.RoleCode,
+		CommunityId: role.CommunityId,
+	}); err != nil {
+		logger.Error("revoke role error",
+			zap.Error(err),
+			zap.Int64("userId", role.UserId),
+			zap.String("roleCode", role.RoleCode))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, nil)
+}
+
+// ListUserRolesHandler 获取用户的角色
+func ListUserRolesHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "ListUserRolesHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.ListUserRoles")
+
+	userIdStr := c.Param("id")
+	userId, err := strconv.ParseInt(userIdStr, 10, 64)
+	if err != nil || userId == 0 {
+		logger.Error("invalid param",
+			zap.Error(err),
+			zap.String("userIdStr", userIdStr))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	resp, err := client.ListUserRoles(c.Request.Context(), &adminPb.ListUserRolesRequest{
+		UserId: userId,
+	})
+	if err != nil {
+		logger.Error("list user roles error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"roles": resp.Roles,
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"star/app/utils/logging"
 	"star/app/utils/request"
 	"star/proto/collect/collectPb"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -81,3 +82,100 @@ func CollectListHandler(c *gin.Context) {
 	return
 
 }
+
+func IsCollectHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "IsCollectHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.IsCollect")
+
+	postId, err := strconv.ParseInt(c.Query("postId"), 10, 64)
+	if err != nil || postId == 0 {
+		logger.Error("is collect error,invalid param",
+			zap.Error(err),
+			zap.String("postId", c.Query("postId")))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Warn("user not log in,but want to check collect",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	resp, err := client.IsCollect(c.Request.Context(), &collectPb.IsCollectRequest{
+		ActorId: userId,
+		PostId:  postId,
+	})
+	if err != nil {
+		logger.Error("is collect service error",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.Int64("postId", postId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"result": resp.Result,
+	})
+}
+
+func GetCollectCountHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "GetCollectCountHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.GetCollectCount")
+
+	postId, err := strconv.ParseInt(c.Query("postId"), 10, 64)
+	if err != nil || postId == 0 {
+		logger.Error("get collect count error,invalid param",
+			zap.Error(err),
+			zap.String("postId", c.Query("postId")))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	resp, err := client.GetCollectCount(c.Request.Context(), &collectPb.GetCollectCountRequest{
+		PostId: postId,
+	})
+	if err != nil {
+		logger.Error("get collect count service error",
+			zap.Error(err),
+			zap.Int64("postId", postId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"count": resp.Count,
+	})
+}
+
+func GetUserCollectCountHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "GetUserCollectCountHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.GetUserCollectCount")
+
+	userIdStr := c.Param("id")
+	userId, err := strconv.ParseInt(userIdStr, 10, 64)
+	if err != nil || userId == 0 {
+		logger.Error("get user collect count error,invalid param",
+			zap.Error(err),
+			zap.String("userIdStr", userIdStr))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	resp, err := client.GetUserCollectCount(c.Request.Context(), &collectPb.GetUserCollectCountRequest{
+		UserId: userId,
+	})
+	if err != nil {
+		logger.Error("get user collect count service error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"count": resp.Count,
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"star/app/gateway/client"
 	"star/app/gateway/models"
 	"star/app/utils/logging"
+	"star/app/utils/request"
 	"star/proto/comment/commentPb"
 	"strconv"
 
@@ -18,7 +19,6 @@ func PostComment(c *gin.Context) {
 	// 测试样例：127.0.0.1:9090/comment
 	//{
 	//	"postId":1,
-	//	"userId":123,
 	//	"content":"家人们",
 	//	"beCommentId":1819704700270809088
 	//}
@@ -35,6 +35,14 @@ func PostComment(c *gin.Context) {
 		str.Response(c, str.ErrInvalidParam, nil)
 		return
 	}
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Warn("user not log in,but want to post comment",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	p.UserId = userId
 	// 发布处理
 	req := &commentPb.PostCommentRequest{
 		PostId:      p.PostId,
@@ -73,12 +81,20 @@ func DeleteComment(c *gin.Context) {
 		str.Response(c, str.ErrInvalidParam, nil)
 		return
 	}
-	req := &commentPb.DeleteCommentRequest{CommentId: id}
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Warn("user not log in,but want to delete comment",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	req := &commentPb.DeleteCommentRequest{CommentId: id, ActorId: userId}
 	_, err = client.DeleteComment(c, req)
 	if err != nil {
 		logger.Error("delete comment error",
 			zap.Error(err),
-			zap.Int64("commentId", id))
+			zap.Int64("commentId", id),
+			zap.Int64("actorId", userId))
 		str.Response(c, err, nil)
 		return
 	}
@@ -100,7 +116,16 @@ func GetComments(c *gin.Context) {
 		str.Response(c, str.ErrInvalidParam, nil)
 		return
 	}
-	req := &commentPb.GetCommentsRequest{PostId: postId}
+	sortType, _ := strconv.Atoi(c.Query("sort"))
+	pageSize, _ := strconv.Atoi(c.Query("page_size"))
+	replySize, _ := strconv.Atoi(c.Query("reply_size"))
+	req := &commentPb.GetCommentsRequest{
+		PostId:    postId,
+		SortType:  int32(sortType),
+		Cursor:    c.Query("cursor"),
+		PageSize:  int32(pageSize),
+		ReplySize: int32(replySize),
+	}
 	resp, err := client.GetComments(c.Request.Context(), req)
 	if err != nil {
 		logger.Error("get comment error",
@@ -112,5 +137,70 @@ func GetComments(c *gin.Context) {
 	// 成功响应
 	str.Response(c, nil, map[string]interface{}{
 		"comments": resp.Comments,
+		"cursor":   resp.NextCursor,
+	})
+}
+
+func GetReplies(c *gin.Context) {
+	// 测试样例：127.0.0.1:9090/comments/replies?commentId=1&cursor=
+	_, span := tracing.Tracer.Start(c.Request.Context(), "GetRepliesHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.GetReplies")
+
+	commentId, err := strconv.ParseInt(c.Query("commentId"), 10, 64)
+	if err != nil {
+		logger.Error("get replies error,invalid param",
+			zap.Error(err))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	pageSize, _ := strconv.Atoi(c.Query("page_size"))
+	req := &commentPb.GetRepliesRequest{
+		CommentId: commentId,
+		Cursor:    c.Query("cursor"),
+		PageSize:  int32(pageSize),
+	}
+	resp, err := client.GetReplies(c.Request.Context(), req)
+	if err != nil {
+		logger.Error("get replies error",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+
+	// 成功响应
+	str.Response(c, nil, map[string]interface{}{
+		"replies": resp.Replies,
+		"cursor":  resp.NextCursor,
+	})
+}
+
+func CountCommentHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "CountCommentHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.CountComment")
+
+	postId, err := strconv.ParseInt(c.Query("postId"), 10, 64)
+	if err != nil || postId == 0 {
+		logger.Error("count comment error,invalid param",
+			zap.Error(err),
+			zap.String("postId", c.Query("postId")))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	resp, err := client.CountComment(c.Request.Context(), &commentPb.CountCommentRequest{
+		PostId: postId,
+	})
+	if err != nil {
+		logger.Error("count comment service error",
+			zap.Error(err),
+			zap.Int64("postId", postId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"count": resp.Count,
 	})
 }
@@ -87,7 +87,7 @@ func FollowCommunityHandler(c *gin.Context) {
 	logger := logging.LogServiceWithTrace(span, "GateWay.FollowCommunityList")
 
 	communityIdStr := c.Param("id")
-	communityId, err := strconv.ParseInt(communityIdStr, 64, 10)
+	communityId, err := strconv.ParseInt(communityIdStr, 10, 64)
 	if err != nil || communityId == 0 {
 		logger.Error("follow user error,invalid param",
 			zap.Error(err),
@@ -125,8 +125,8 @@ func UnFollowCommunityHandler(c *gin.Context) {
 	logging.SetSpanWithHostname(span)
 	logger := logging.LogServiceWithTrace(span, "GateWay.UnFollowCommunity")
 
-	communityIdStr := c.Query("id")
-	communityId, err := strconv.ParseInt(communityIdStr, 64, 10)
+	communityIdStr := c.Param("id")
+	communityId, err := strconv.ParseInt(communityIdStr, 10, 64)
 	if err != nil || communityId == 0 {
 		logger.Error("unfollow community error,invalid param",
 			zap.Error(err),
@@ -165,7 +165,7 @@ func GetCommunityInfoHandler(c *gin.Context) {
 	logger := logging.LogServiceWithTrace(span, "GateWay.GetCommunityInfo")
 
 	communityIdStr := c.Param("id")
-	communityId, err := strconv.ParseInt(communityIdStr, 64, 10)
+	communityId, err := strconv.ParseInt(communityIdStr, 10, 64)
 	if err != nil || communityId == 0 {
 		logger.Error("invalid param",
 			zap.Error(err),
@@ -180,6 +180,7 @@ func GetCommunityInfoHandler(c *gin.Context) {
 		logger.Error("get community info service error",
 			zap.Error(err))
 		str.Response(c, err, nil)
+		return
 	}
 
 	str.Response(c, nil, map[string]interface{}{
@@ -203,3 +204,73 @@ func validateDescriptionAndName(community *models.Community) error {
 	}
 	return nil
 }
+
+func IsFollowCommunityHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "IsFollowCommunityHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.IsFollowCommunity")
+
+	communityIdStr := c.Param("id")
+	communityId, err := strconv.ParseInt(communityIdStr, 10, 64)
+	if err != nil || communityId == 0 {
+		logger.Error("is follow community error,invalid param",
+			zap.Error(err),
+			zap.String("communityIdStr", communityIdStr))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Warn("user not log in,but want to check follow community",
+			zap.Error(err),
+			zap.Int64("communityId", communityId))
+		str.Response(c, err, nil)
+		return
+	}
+	resp, err := client.IsFollowCommunity(c.Request.Context(), &communityPb.IsFollowCommunityRequest{
+		ActorId:     userId,
+		CommunityId: communityId,
+	})
+	if err != nil {
+		logger.Error("is follow community service error",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.Int64("communityId", communityId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"result": resp.Result,
+	})
+}
+
+func CountCommunityFollowHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "CountCommunityFollowHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.CountCommunityFollow")
+
+	userIdStr := c.Param("id")
+	userId, err := strconv.ParseInt(userIdStr, 10, 64)
+	if err != nil || userId == 0 {
+		logger.Error("count community follow error,invalid param",
+			zap.Error(err),
+			zap.String("userIdStr", userIdStr))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	resp, err := client.CountCommunityFollow(c.Request.Context(), &communityPb.CountCommunityFollowRequest{
+		UserId: userId,
+	})
+	if err != nil {
+		logger.Error("count community follow service error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"count": resp.Count,
+	})
+}
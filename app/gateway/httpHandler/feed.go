@@ -29,6 +29,10 @@ func GetCommunityPostByTimeHandler(c *gin.Context) {
 		str.Response(c, str.ErrInvalidParam, nil)
 		return
 	}
+	//登录时返回当前用户的点赞、收藏状态
+	if actorId, err := request.GetUserId(c); err == nil {
+		p.ActorId = actorId
+	}
 	resp, err := client.GetCommunityPostByTime(c.Request.Context(), &feedPb.GetCommunityPostByTimeRequest{
 		CommunityId: p.CommunityId,
 		Page:        p.Page,
@@ -67,6 +71,10 @@ func GetCommunityPostByNewReplyHandler(c *gin.Context) {
 		str.Response(c, str.ErrInvalidParam, nil)
 		return
 	}
+	//登录时返回当前用户的点赞、收藏状态
+	if actorId, err := request.GetUserId(c); err == nil {
+		p.ActorId = actorId
+	}
 	resp, err := client.GetCommunityPostByNewRely(c.Request.Context(), &feedPb.GetCommunityPostByNewReplyRequest{
 		CommunityId:   p.CommunityId,
 		ActorId:       p.ActorId,
@@ -95,12 +103,19 @@ func GetPostByNewRelationHandler(c *gin.Context) {
 	logging.SetSpanWithHostname(span)
 	logger := logging.LogServiceWithTrace(span, "GateWay.GetPostByNewRelation")
 
-	pageStr := c.Query("page")
-	page, err := strconv.ParseInt(pageStr, 10, 64)
+	lastPostId, err := strconv.ParseInt(c.DefaultQuery("last_post_id", "0"), 10, 64)
+	if err != nil {
+		logger.Error("invalid param",
+			zap.Error(err),
+			zap.String("lastPostId", c.Query("last_post_id")))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	limit, err := strconv.ParseInt(c.DefaultQuery("limit", "0"), 10, 64)
 	if err != nil {
 		logger.Error("invalid param",
 			zap.Error(err),
-			zap.String("pageStr", pageStr))
+			zap.String("limit", c.Query("limit")))
 		str.Response(c, str.ErrInvalidParam, nil)
 		return
 	}
@@ -112,19 +127,21 @@ func GetPostByNewRelationHandler(c *gin.Context) {
 		return
 	}
 	resp, err := client.GetPostByRelation(c.Request.Context(), &feedPb.GetPostByRelationRequest{
-		ActorId: userId,
-		Page:    page,
+		ActorId:    userId,
+		LastPostId: lastPostId,
+		Limit:      limit,
 	})
 	if err != nil {
-		logger.Error("get feed by time service error",
+		logger.Error("get feed by relation service error",
 			zap.Error(err),
 			zap.Int64("actorId", userId),
-			zap.Int64("page", page))
+			zap.Int64("lastPostId", lastPostId))
 		str.Response(c, err, nil)
 		return
 	}
 	str.Response(c, nil, map[string]interface{}{
-		"posts": resp.Posts,
+		"posts":        resp.Posts,
+		"last_post_id": resp.NewPostId,
 	})
 	return
 }
@@ -1,14 +1,17 @@
 package httpHandler
 
 import (
+	"errors"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	"net/http"
 	"star/app/constant/str"
 	"star/app/extra/tracing"
 	"star/app/storage/file"
 	"star/app/utils/logging"
 )
 
+// FileUploadHandler 上传图片，type为图片用途(avatar、community、category_icon、category_background、post)，默认为post
 func FileUploadHandler(c *gin.Context) {
 	_, span := tracing.Tracer.Start(c.Request.Context(), "FileUploadHandler")
 	defer span.End()
@@ -20,14 +23,47 @@ func FileUploadHandler(c *gin.Context) {
 		str.Response(c, str.ErrInvalidParam, nil)
 		return
 	}
-	url, err := file.UploadToQiNiu(c.Request.Context(), str.DirImg, str.UploadMarkImg, f, logger)
+	usage := c.DefaultPostForm("type", file.ImagePost)
+	if !file.IsImageUsage(usage) {
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	urls, err := file.UploadImage(c.Request.Context(), str.DirImg, str.UploadMarkImg, usage, f, logger)
 	if err != nil {
 		logger.Error("upload file error",
 			zap.Error(err))
+		if errors.Is(err, str.ErrInvalidImage) || errors.Is(err, str.ErrImageTooLarge) {
+			str.Response(c, err, nil)
+			return
+		}
 		str.Response(c, str.ErrUpload, nil)
 		return
 	}
 	str.Response(c, nil, map[string]interface{}{
-		"data": url,
+		"data":     urls[file.VariantOrigin],
+		"variants": urls,
 	})
 }
+
+// LocalFileHandler 本地存储时提供文件访问，非公开目录的文件和带签名参数的链接需校验签名和有效期
+func LocalFileHandler(c *gin.Context) {
+	local, ok := file.Default.(*file.LocalStorage)
+	if !ok {
+		c.Status(http.StatusNotFound)
+		return
+	}
+	key := c.Param("key")
+	deadline, signed := c.GetQuery("e")
+	if signed || !file.IsPublicKey(key) {
+		if !local.VerifySignedURL(key, deadline, c.Query("token")) {
+			c.Status(http.StatusForbidden)
+			return
+		}
+	}
+	path, err := local.Path(key)
+	if err != nil {
+		c.Status(http.StatusNotFound)
+		return
+	}
+	c.File(path)
+}
@@ -0,0 +1,32 @@
+package httpHandler
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"star/app/constant/str"
+	"star/app/extra/tracing"
+	"star/app/utils/jwt"
+	"star/app/utils/logging"
+)
+
+// JWKSHandler 返回验证token的公钥，格式为JWK Set而不是统一的响应结构
+func JWKSHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "JWKSHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.JWKS")
+
+	keys, err := jwt.JWKS()
+	if err != nil {
+		logger.Error("load jwt keys error",
+			zap.Error(err))
+		str.Response(c, str.ErrServiceBusy, nil)
+		return
+	}
+	//轮换密钥时新公钥需要尽快生效，缓存时间不宜过长
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, gin.H{
+		"keys": keys,
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"star/app/utils/logging"
 	"star/app/utils/request"
 	"star/proto/like/likePb"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -85,3 +86,184 @@ func LikeListHandler(c *gin.Context) {
 	})
 	return
 }
+
+func ReconcileFavorCountHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "ReconcileFavorCountHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.ReconcileFavorCount")
+
+	p := new(models.ReconcileFavorCount)
+	if err := c.ShouldBindJSON(p); err != nil {
+		logger.Error("reconcile favor count error,invalid param",
+			zap.Error(err))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	resp, err := client.ReconcileFavorCount(c.Request.Context(), &likePb.ReconcileFavorCountRequest{
+		Kind:      p.Kind,
+		SourceIds: p.SourceIds,
+		Full:      p.Full,
+		Cursor:    p.Cursor,
+		Limit:     p.Limit,
+		Repair:    p.Repair,
+	})
+	if err != nil {
+		logger.Error("reconcile favor count service error",
+			zap.Error(err),
+			zap.String("kind", p.Kind),
+			zap.String("repair", p.Repair))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"results": resp.Results,
+	})
+}
+
+func IsLikeHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "IsLikeHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.IsLike")
+
+	sourceId, err := strconv.ParseInt(c.Query("sourceId"), 10, 64)
+	if err != nil || sourceId == 0 {
+		logger.Error("is like error,invalid param",
+			zap.Error(err),
+			zap.String("sourceId", c.Query("sourceId")))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	sourceType, err := strconv.ParseUint(c.Query("sourceType"), 10, 32)
+	if err != nil || sourceType == 0 {
+		logger.Error("is like error,invalid param",
+			zap.Error(err),
+			zap.String("sourceType", c.Query("sourceType")))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Warn("user not log in,but want to check like",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	resp, err := client.IsLike(c.Request.Context(), &likePb.IsLikeRequest{
+		ActorId:    userId,
+		SourceId:   sourceId,
+		SourceType: uint32(sourceType),
+	})
+	if err != nil {
+		logger.Error("is like service error",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.Int64("sourceId", sourceId),
+			zap.Uint64("sourceType", sourceType))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"result": resp.Result,
+	})
+}
+
+func GetLikeCountHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "GetLikeCountHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.GetLikeCount")
+
+	sourceId, err := strconv.ParseInt(c.Query("sourceId"), 10, 64)
+	if err != nil || sourceId == 0 {
+		logger.Error("get like count error,invalid param",
+			zap.Error(err),
+			zap.String("sourceId", c.Query("sourceId")))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	sourceType, err := strconv.ParseUint(c.Query("sourceType"), 10, 32)
+	if err != nil || sourceType == 0 {
+		logger.Error("get like count error,invalid param",
+			zap.Error(err),
+			zap.String("sourceType", c.Query("sourceType")))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	resp, err := client.GetLikeCount(c.Request.Context(), &likePb.GetLikeCountRequest{
+		SourceId:   sourceId,
+		SourceType: uint32(sourceType),
+	})
+	if err != nil {
+		logger.Error("get like count service error",
+			zap.Error(err),
+			zap.Int64("sourceId", sourceId),
+			zap.Uint64("sourceType", sourceType))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"count": resp.Count,
+	})
+}
+
+func GetUserTotalLikeHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "GetUserTotalLikeHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.GetUserTotalLike")
+
+	userIdStr := c.Param("id")
+	userId, err := strconv.ParseInt(userIdStr, 10, 64)
+	if err != nil || userId == 0 {
+		logger.Error("get user total like error,invalid param",
+			zap.Error(err),
+			zap.String("userIdStr", userIdStr))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	resp, err := client.GetUserTotalLike(c.Request.Context(), &likePb.GetUserTotalLikeRequest{
+		UserId: userId,
+	})
+	if err != nil {
+		logger.Error("get user total like service error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"count": resp.Count,
+	})
+}
+
+func GetUserLikeCountHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "GetUserLikeCountHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.GetUserLikeCount")
+
+	userIdStr := c.Param("id")
+	userId, err := strconv.ParseInt(userIdStr, 10, 64)
+	if err != nil || userId == 0 {
+		logger.Error("get user like count error,invalid param",
+			zap.Error(err),
+			zap.String("userIdStr", userIdStr))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	resp, err := client.GetUserLikeCount(c.Request.Context(), &likePb.GetUserLikeCountRequest{
+		UserId: userId,
+	})
+	if err != nil {
+		logger.Error("get user like count service error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"count": resp.Count,
+	})
+}
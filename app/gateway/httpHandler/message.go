@@ -4,10 +4,12 @@ import (
 	"star/app/constant/str"
 	"star/app/extra/tracing"
 	"star/app/gateway/client"
+	"star/app/gateway/models"
 	"star/app/utils/logging"
 	"star/app/utils/request"
 	"star/proto/message/messagePb"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -48,17 +50,69 @@ func SendSystemMessageHandler(c *gin.Context) {
 	logging.SetSpanWithHostname(span)
 	logger := logging.LogServiceWithTrace(span, "GateWay.SendSystemMessage")
 
+	p := new(models.SendSystemMessage)
+	if err := c.ShouldBindJSON(p); err != nil {
+		logger.Error("send system message error,invalid param",
+			zap.Error(err))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	managerId, err := request.GetUserId(c)
+	if err != nil {
+		str.Response(c, err, nil)
+		return
+	}
 	req := &messagePb.SendSystemMessageRequest{
-		ManagerId:   1820019310731464704,
-		RecipientId: 0,
-		Type:        "all",
-		Title:       "Test",
-		Content:     "test send system message",
+		ManagerId:    managerId,
+		RecipientId:  p.RecipientId,
+		Type:         p.Type,
+		Title:        p.Title,
+		Content:      p.Content,
+		CommunityId:  p.CommunityId,
+		RecipientIds: p.RecipientIds,
 	}
-	_, err := client.SendSystemMessage(c.Request.Context(), req)
+	if !p.PublishTime.IsZero() {
+		req.PublishAt = p.PublishTime.Unix()
+	}
+	resp, err := client.SendSystemMessage(c.Request.Context(), req)
 	if err != nil {
 		logger.Error("send system message error",
+			zap.Error(err),
+			zap.String("type", p.Type))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"systemMessageId": resp.SystemMessageId,
+	})
+}
+
+func RevokeSystemMessageHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "RevokeSystemMessageHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.RevokeSystemMessage")
+
+	p := new(models.RevokeSystemMessage)
+	if err := c.ShouldBindJSON(p); err != nil {
+		logger.Error("revoke system message error,invalid param",
 			zap.Error(err))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	managerId, err := request.GetUserId(c)
+	if err != nil {
+		str.Response(c, err, nil)
+		return
+	}
+	_, err = client.RevokeSystemMessage(c.Request.Context(), &messagePb.RevokeSystemMessageRequest{
+		ManagerId:       managerId,
+		SystemMessageId: p.SystemMessageId,
+	})
+	if err != nil {
+		logger.Error("revoke system message error",
+			zap.Error(err),
+			zap.Int64("systemMessageId", p.SystemMessageId))
 		str.Response(c, err, nil)
 		return
 	}
@@ -102,3 +156,392 @@ func SendPrivateMessageHandler(c *gin.Context) {
 	}
 	str.Response(c, nil, nil)
 }
+
+func SendGroupMessageHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "SendGroupMessageHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.SendGroupMessage")
+
+	p := new(models.SendGroupMessage)
+	if err := c.ShouldBindJSON(p); err != nil {
+		logger.Error("send group message error,invalid param",
+			zap.Error(err))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	senderId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Error("get user id error",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	resp, err := client.SendGroupMessage(c.Request.Context(), &messagePb.SendGroupMessageRequest{
+		SenderId:    senderId,
+		CommunityId: p.CommunityId,
+		Content:     p.Content,
+	})
+	if err != nil {
+		logger.Error("send group message failed",
+			zap.Error(err),
+			zap.Int64("senderId", senderId),
+			zap.Int64("communityId", p.CommunityId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"message": resp.Message,
+	})
+}
+
+func LoadGroupMessageHandler(c *gin.Context) {
+	// 测试样例：127.0.0.1:9090/group/messages?communityId=1&lastChatId=
+	_, span := tracing.Tracer.Start(c.Request.Context(), "LoadGroupMessageHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.LoadGroupMessage")
+
+	communityId, err := strconv.ParseInt(c.Query("communityId"), 10, 64)
+	if err != nil {
+		logger.Error("load group message error,invalid param",
+			zap.Error(err))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Error("get user id error",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	lastChatId, _ := strconv.ParseInt(c.Query("lastChatId"), 10, 64)
+	limit, _ := strconv.ParseInt(c.Query("limit"), 10, 64)
+	resp, err := client.LoadGroupMessage(c.Request.Context(), &messagePb.LoadGroupMessageRequest{
+		UserId:      userId,
+		CommunityId: communityId,
+		LastChatId:  lastChatId,
+		Limit:       limit,
+	})
+	if err != nil {
+		logger.Error("load group message failed",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.Int64("communityId", communityId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"messages":   resp.GroupMessages,
+		"nextChatId": resp.NextChatId,
+	})
+}
+
+func GetGroupUnreadCountHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "GetGroupUnreadCountHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.GetGroupUnreadCount")
+
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Error("get user id error",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	resp, err := client.GetGroupUnreadCount(c.Request.Context(), &messagePb.GetGroupUnreadCountRequest{
+		UserId: userId,
+	})
+	if err != nil {
+		logger.Error("get group unread count failed",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"unreadCounts": resp.UnreadCounts,
+	})
+}
+
+func MarkGroupReadHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "MarkGroupReadHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.MarkGroupRead")
+
+	p := new(models.MarkGroupRead)
+	if err := c.ShouldBindJSON(p); err != nil {
+		logger.Error("mark group read error,invalid param",
+			zap.Error(err))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Error("get user id error",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	_, err = client.MarkGroupRead(c.Request.Context(), &messagePb.MarkGroupReadRequest{
+		UserId:      userId,
+		CommunityId: p.CommunityId,
+		ChatId:      p.ChatId,
+	})
+	if err != nil {
+		logger.Error("mark group read failed",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.Int64("communityId", p.CommunityId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, nil)
+}
+
+func MarkChatReadHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "MarkChatReadHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.MarkChatRead")
+
+	p := new(models.MarkChatRead)
+	if err := c.ShouldBindJSON(p); err != nil {
+		logger.Error("mark chat read error,invalid param",
+			zap.Error(err))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Error("get user id error",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	_, err = client.MarkChatRead(c.Request.Context(), &messagePb.MarkChatReadRequest{
+		UserId:        userId,
+		SenderId:      p.SenderId,
+		LastMessageId: p.LastMessageId,
+	})
+	if err != nil {
+		logger.Error("mark chat read failed",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.Int64("senderId", p.SenderId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, nil)
+}
+
+func MarkRemindReadHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "MarkRemindReadHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.MarkRemindRead")
+
+	p := new(models.MarkRemindRead)
+	if err := c.ShouldBindJSON(p); err != nil {
+		logger.Error("mark remind read error,invalid param",
+			zap.Error(err))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Error("get user id error",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	_, err = client.MarkRemindRead(c.Request.Context(), &messagePb.MarkRemindReadRequest{
+		UserId: userId,
+		Type:   p.Type,
+	})
+	if err != nil {
+		logger.Error("mark remind read failed",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.String("type", p.Type))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, nil)
+}
+
+func MarkAllReadHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "MarkAllReadHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.MarkAllRead")
+
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Error("get user id error",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	_, err = client.MarkAllRead(c.Request.Context(), &messagePb.MarkAllReadRequest{
+		UserId: userId,
+	})
+	if err != nil {
+		logger.Error("mark all read failed",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, nil)
+}
+
+func ListRemindMessagesHandler(c *gin.Context) {
+	// 测试样例：127.0.0.1:9090/message/reminds?type=like&cursor=
+	_, span := tracing.Tracer.Start(c.Request.Context(), "ListRemindMessagesHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.ListRemindMessages")
+
+	remindType := c.Query("type")
+	if remindType == "" {
+		logger.Error("list remind messages error,invalid param")
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Error("get user id error",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	cursor, _ := strconv.ParseInt(c.Query("cursor"), 10, 64)
+	limit, _ := strconv.ParseInt(c.Query("limit"), 10, 64)
+	resp, err := client.ListRemindMessages(c.Request.Context(), &messagePb.ListRemindMessagesRequest{
+		UserId: userId,
+		Type:   remindType,
+		Cursor: cursor,
+		Limit:  limit,
+	})
+	if err != nil {
+		logger.Error("list remind messages failed",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.String("type", remindType))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"reminds":    resp.RemindMessages,
+		"nextCursor": resp.NextCursor,
+	})
+}
+
+func ListSystemMessagesHandler(c *gin.Context) {
+	// 测试样例：127.0.0.1:9090/message/system?cursor=
+	_, span := tracing.Tracer.Start(c.Request.Context(), "ListSystemMessagesHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.ListSystemMessages")
+
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Error("get user id error",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	cursor, _ := strconv.ParseInt(c.Query("cursor"), 10, 64)
+	limit, _ := strconv.ParseInt(c.Query("limit"), 10, 64)
+	resp, err := client.ListSystemMessages(c.Request.Context(), &messagePb.ListSystemMessagesRequest{
+		UserId: userId,
+		Cursor: cursor,
+		Limit:  limit,
+	})
+	if err != nil {
+		logger.Error("list system messages failed",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"messages":   resp.SystemMessages,
+		"nextCursor": resp.NextCursor,
+	})
+}
+
+func GetChatListHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "GetChatListHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.GetChatList")
+
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Error("get user id error",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	resp, err := client.GetChatList(c.Request.Context(), &messagePb.GetChatListRequest{
+		UserId: userId,
+	})
+	if err != nil {
+		logger.Error("get chat list error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"chatList": resp.PrivateChatList,
+	})
+}
+
+// LoadMessageHandler 加载与userId的私信，lastMsgTime为空时从最新的消息开始
+func LoadMessageHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "LoadMessageHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.LoadMessage")
+
+	senderId, err := strconv.ParseInt(c.Query("userId"), 10, 64)
+	if err != nil || senderId == 0 {
+		logger.Error("parse sender id failed",
+			zap.Error(err),
+			zap.String("userId", c.Query("userId")))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	privateChatId, _ := strconv.ParseInt(c.Query("privateChatId"), 10, 64)
+	lastMsgTime := c.DefaultQuery("lastMsgTime", time.Now().Format(str.ParseTimeFormat))
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Error("get user id error",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	resp, err := client.LoadMessage(c.Request.Context(), &messagePb.LoadMessageRequest{
+		SenderId:      senderId,
+		RecipientId:   userId,
+		PrivateChatId: privateChatId,
+		LastMsgTime:   lastMsgTime,
+	})
+	if err != nil {
+		logger.Error("load private message error",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.Int64("senderId", senderId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"messages": resp.PrivateMessages,
+	})
+}
@@ -1,6 +1,8 @@
 package httpHandler
 
 import (
+	"io"
+	"net/http"
 	"star/app/constant/str"
 	"star/app/extra/tracing"
 	"star/app/gateway/client"
@@ -8,11 +10,17 @@ import (
 	"star/app/utils/logging"
 	"star/app/utils/request"
 	"star/proto/publish/publishPb"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 )
 
+const (
+	maxVideoChunkSize = 10 << 20 //与发布服务的单个分片大小限制一致
+	maxChunkFormSize  = 1 << 20  //分片之外的表单字段
+)
+
 func PreUploadVideosHandler(c *gin.Context) {
 	_, span := tracing.Tracer.Start(c.Request.Context(), "PreUploadVideos")
 	defer span.End()
@@ -50,6 +58,121 @@ func PreUploadVideosHandler(c *gin.Context) {
 	})
 }
 
+func UploadVideoChunkHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "UploadVideoChunk")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.UploadVideoChunk")
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxVideoChunkSize+maxChunkFormSize)
+	chunk := new(models.UploadVideoChunk)
+	if err := c.ShouldBind(chunk); err != nil {
+		logger.Error("upload video chunk error because invalid param",
+			zap.Error(err))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Error("get user id error",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	fileHeader, err := c.FormFile("chunk")
+	if err != nil {
+		logger.Error("get video chunk error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	if fileHeader.Size > maxVideoChunkSize {
+		logger.Error("video chunk too large",
+			zap.Int64("userId", userId),
+			zap.Int64("size", fileHeader.Size))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	src, err := fileHeader.Open()
+	if err != nil {
+		logger.Error("open video chunk error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, str.ErrUpload, nil)
+		return
+	}
+	defer src.Close()
+	data, err := io.ReadAll(io.LimitReader(src, maxVideoChunkSize))
+	if err != nil {
+		logger.Error("read video chunk error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, str.ErrUpload, nil)
+		return
+	}
+	resp, err := client.UploadVideoChunk(c.Request.Context(), &publishPb.UploadVideoChunkRequest{
+		UploadId:   chunk.UploadId,
+		ChunkIndex: chunk.ChunkIndex,
+		Data:       data,
+		ActorId:    userId,
+	})
+	if err != nil {
+		logger.Error("upload video chunk error",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.String("uploadId", chunk.UploadId),
+			zap.Uint32("chunkIndex", chunk.ChunkIndex))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"uploadedChunks": resp.UploadedChunks,
+	})
+}
+
+func CompleteVideoUploadHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "CompleteVideoUpload")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.CompleteVideoUpload")
+
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Error("get user id error",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	p := new(models.CompleteVideoUpload)
+	if err := c.ShouldBindJSON(p); err != nil {
+		logger.Error("complete video upload error because invalid param",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	resp, err := client.CompleteVideoUpload(c.Request.Context(), &publishPb.CompleteVideoUploadRequest{
+		UploadId:    p.UploadId,
+		ActorId:     userId,
+		CommunityId: p.CommunityId,
+		Content:     p.Content,
+		IsScan:      p.IsScan,
+	})
+	if err != nil {
+		logger.Error("complete video upload error",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.String("uploadId", p.UploadId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"postId":    resp.PostId,
+		"videoPath": resp.VideoPath,
+	})
+}
+
 func CreatePostHandler(c *gin.Context) {
 	_, span := tracing.Tracer.Start(c.Request.Context(), "CreatePostHandler")
 	defer span.End()
@@ -90,3 +213,142 @@ func CreatePostHandler(c *gin.Context) {
 	str.Response(c, nil, nil)
 	return
 }
+
+func UpdatePostHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "UpdatePostHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.UpdatePost")
+
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Warn("user not log in,but want to update post")
+		str.Response(c, err, nil)
+		return
+	}
+	p := new(models.UpdatePost)
+	if err := c.ShouldBindJSON(p); err != nil {
+		logger.Error("update post error,invalid param",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	_, err = client.UpdatePost(c.Request.Context(), &publishPb.UpdatePostRequest{
+		ActorId: userId,
+		PostId:  p.PostId,
+		Content: p.Content,
+		IsScan:  p.IsScan,
+	})
+	if err != nil {
+		logger.Error("update post service error",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.Int64("postId", p.PostId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, nil)
+}
+
+func DeletePostHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "DeletePostHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.DeletePost")
+
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Warn("user not log in,but want to delete post")
+		str.Response(c, err, nil)
+		return
+	}
+	p := new(models.DeletePost)
+	if err := c.ShouldBindJSON(p); err != nil {
+		logger.Error("delete post error,invalid param",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	_, err = client.DeletePost(c.Request.Context(), &publishPb.DeletePostRequest{
+		ActorId: userId,
+		PostId:  p.PostId,
+	})
+	if err != nil {
+		logger.Error("delete post service error",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.Int64("postId", p.PostId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, nil)
+}
+
+func CountPostHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "CountPostHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.CountPost")
+
+	userIdStr := c.Param("id")
+	userId, err := strconv.ParseInt(userIdStr, 10, 64)
+	if err != nil || userId == 0 {
+		logger.Error("count post error,invalid param",
+			zap.Error(err),
+			zap.String("userIdStr", userIdStr))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	resp, err := client.CountPost(c.Request.Context(), &publishPb.CountPostRequest{
+		UserId: userId,
+	})
+	if err != nil {
+		logger.Error("count post service error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"count": resp.Count,
+	})
+}
+
+// ListPostHandler 用户发布的帖子列表，登录时返回当前用户的点赞、收藏状态
+func ListPostHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "ListPostHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.ListPost")
+
+	userIdStr := c.Param("id")
+	userId, err := strconv.ParseInt(userIdStr, 10, 64)
+	if err != nil || userId == 0 {
+		logger.Error("list post error,invalid param",
+			zap.Error(err),
+			zap.String("userIdStr", userIdStr))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	actorId, err := request.GetUserId(c)
+	if err != nil {
+		actorId = 0
+	}
+	resp, err := client.ListPost(c.Request.Context(), &publishPb.ListPostRequest{
+		ActorId: actorId,
+		UserId:  userId,
+	})
+	if err != nil {
+		logger.Error("list post service error",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.Int64("actorId", actorId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"posts": resp.Posts,
+	})
+}
@@ -19,8 +19,8 @@ func FollowHandler(c *gin.Context) {
 	logging.SetSpanWithHostname(span)
 	logger := logging.LogServiceWithTrace(span, "GateWay.Follow")
 
-	beFollowIdStr := c.Query("beFollowId")
-	beFollowId, err := strconv.ParseInt(beFollowIdStr, 64, 10)
+	beFollowIdStr := c.Param("id")
+	beFollowId, err := strconv.ParseInt(beFollowIdStr, 10, 64)
 	if err != nil || beFollowId == 0 {
 		logger.Error("follow user error,invalid param",
 			zap.Error(err),
@@ -58,8 +58,8 @@ func UnFollowHandler(c *gin.Context) {
 	logging.SetSpanWithHostname(span)
 	logger := logging.LogServiceWithTrace(span, "GateWay.UnFollow")
 
-	unBeFollowIdStr := c.Query("beFollowId")
-	unBeFollowId, err := strconv.ParseInt(unBeFollowIdStr, 64, 10)
+	unBeFollowIdStr := c.Param("id")
+	unBeFollowId, err := strconv.ParseInt(unBeFollowIdStr, 10, 64)
 	if err != nil || unBeFollowId == 0 {
 		logger.Error("unfollow user error,invalid param",
 			zap.Error(err),
@@ -150,3 +150,103 @@ func GetFansListHandler(c *gin.Context) {
 	})
 	return
 }
+
+func IsFollowHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "IsFollowHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.IsFollow")
+
+	followIdStr := c.Param("id")
+	followId, err := strconv.ParseInt(followIdStr, 10, 64)
+	if err != nil || followId == 0 {
+		logger.Error("is follow error,invalid param",
+			zap.Error(err),
+			zap.String("followIdStr", followIdStr))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Warn("user not log in,but want to check follow",
+			zap.Error(err),
+			zap.Int64("followId", followId))
+		str.Response(c, err, nil)
+		return
+	}
+	resp, err := client.IsFollow(c.Request.Context(), &relationPb.IsFollowRequest{
+		UserId:   userId,
+		FollowId: followId,
+	})
+	if err != nil {
+		logger.Error("is follow service error",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.Int64("followId", followId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"result": resp.Result,
+	})
+}
+
+func CountFollowHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "CountFollowHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.CountFollow")
+
+	userIdStr := c.Param("id")
+	userId, err := strconv.ParseInt(userIdStr, 10, 64)
+	if err != nil || userId == 0 {
+		logger.Error("count follow error,invalid param",
+			zap.Error(err),
+			zap.String("userIdStr", userIdStr))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	resp, err := client.CountFollow(c.Request.Context(), &relationPb.CountFollowRequest{
+		UserId: userId,
+	})
+	if err != nil {
+		logger.Error("count follow service error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"count": resp.Count,
+	})
+}
+
+func CountFansHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "CountFansHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.CountFans")
+
+	userIdStr := c.Param("id")
+	userId, err := strconv.ParseInt(userIdStr, 10, 64)
+	if err != nil || userId == 0 {
+		logger.Error("count fans error,invalid param",
+			zap.Error(err),
+			zap.String("userIdStr", userIdStr))
+		str.Response(c, str.ErrInvalidParam, nil)
+		return
+	}
+	resp, err := client.CountFans(c.Request.Context(), &relationPb.CountFansRequest{
+		UserId: userId,
+	})
+	if err != nil {
+		logger.Error("count fans service error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"count": resp.Count,
+	})
+}
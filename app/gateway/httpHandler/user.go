@@ -5,7 +5,6 @@ import (
 	"star/app/constant/str"
 	"star/app/extra/tracing"
 	"star/app/gateway/models"
-	"star/app/utils/jwt"
 	"star/app/utils/logging"
 	"star/app/utils/request"
 	"star/proto/user/userPb"
@@ -50,6 +49,8 @@ func LoginHandler(c *gin.Context) {
 	req := &userPb.LSRequest{
 		User:     u.User,
 		Password: u.Password,
+		Ip:       c.RemoteIP(),
+		Device:   c.Request.UserAgent(),
 	}
 	resp, err := client.LoginPassword(c.Request.Context(), req)
 	if err != nil {
@@ -89,6 +90,8 @@ func LoginWithCaptchaHandler(c *gin.Context) {
 	req := &userPb.LSRequest{
 		Phone:   u.Phone,
 		Captcha: u.Captcha,
+		Ip:      c.RemoteIP(),
+		Device:  c.Request.UserAgent(),
 	}
 	resp, err := client.LoginCaptcha(c.Request.Context(), req)
 	if err != nil {
@@ -105,6 +108,7 @@ func LoginWithCaptchaHandler(c *gin.Context) {
 	})
 }
 
+// AutoLoginHandler 使用refreshToken自动登录，返回新的token和用户信息
 func AutoLoginHandler(c *gin.Context) {
 	_, span := tracing.Tracer.Start(c.Request.Context(), "AutoLoginHandler")
 	defer span.End()
@@ -118,7 +122,10 @@ func AutoLoginHandler(c *gin.Context) {
 		str.Response(c, str.ErrInvalidParam, nil)
 		return
 	}
-	userId, accessToken, err := jwt.AutoLogin(oldToken.AccessToken, oldToken.RefreshToken)
+	resp, err := client.RefreshToken(c.Request.Context(), &userPb.RefreshTokenRequest{
+		RefreshToken: oldToken.RefreshToken,
+		Ip:           c.RemoteIP(),
+	})
 	if err != nil {
 		logger.Error("auto login refresh token error",
 			zap.Error(err))
@@ -126,7 +133,7 @@ func AutoLoginHandler(c *gin.Context) {
 		return
 	}
 	userInfoResp, err := client.GetUserInfo(c.Request.Context(), &userPb.GetUserInfoRequest{
-		UserId: userId,
+		UserId: resp.UserId,
 	})
 	if err != nil {
 		logger.Error("auto login error because get use info error",
@@ -135,11 +142,13 @@ func AutoLoginHandler(c *gin.Context) {
 		return
 	}
 	str.Response(c, nil, map[string]interface{}{
-		"accessToken": accessToken,
-		"userInfo":    userInfoResp.User,
+		"accessToken":  resp.Token.AccessToken,
+		"refreshToken": resp.Token.RefreshToken,
+		"userInfo":     userInfoResp.User,
 	})
 }
 
+// RefreshTokenHandler 轮换refreshToken，客户端需要保存返回的新refreshToken
 func RefreshTokenHandler(c *gin.Context) {
 	_, span := tracing.Tracer.Start(c.Request.Context(), "RefreshTokenHandler")
 	defer span.End()
@@ -153,7 +162,10 @@ func RefreshTokenHandler(c *gin.Context) {
 		str.Response(c, str.ErrInvalidParam, nil)
 		return
 	}
-	accessToken, err := jwt.RefreshAccessToken(oldToken.AccessToken, oldToken.RefreshToken)
+	resp, err := client.RefreshToken(c.Request.Context(), &userPb.RefreshTokenRequest{
+		RefreshToken: oldToken.RefreshToken,
+		Ip:           c.RemoteIP(),
+	})
 	if err != nil {
 		logger.Error("refresh token error",
 			zap.Error(err))
@@ -161,7 +173,128 @@ func RefreshTokenHandler(c *gin.Context) {
 		return
 	}
 	str.Response(c, nil, map[string]interface{}{
-		"accessToken": accessToken,
+		"accessToken":  resp.Token.AccessToken,
+		"refreshToken": resp.Token.RefreshToken,
+	})
+}
+
+// LogoutHandler 退出当前设备的登录
+func LogoutHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "LogoutHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.Logout")
+
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Warn("user not log in,but want to logout",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	sessionId, err := request.GetSessionId(c)
+	if err != nil {
+		logger.Warn("logout without session",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	if _, err := client.Logout(c.Request.Context(), &userPb.LogoutRequest{
+		UserId:    userId,
+		SessionId: sessionId,
+	}); err != nil {
+		logger.Error("logout error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, nil)
+}
+
+// LogoutSessionHandler 退出指定设备的登录
+func LogoutSessionHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "LogoutSessionHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.LogoutSession")
+
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Warn("user not log in,but want to logout session",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	sessionId := c.Param("id")
+	if _, err := client.Logout(c.Request.Context(), &userPb.LogoutRequest{
+		UserId:    userId,
+		SessionId: sessionId,
+	}); err != nil {
+		logger.Error("logout session error",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.String("sessionId", sessionId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, nil)
+}
+
+// LogoutAllDevicesHandler 退出所有设备的登录，包括当前设备
+func LogoutAllDevicesHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "LogoutAllDevicesHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.LogoutAllDevices")
+
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Warn("user not log in,but want to logout all devices",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	if _, err := client.LogoutAllDevices(c.Request.Context(), &userPb.LogoutAllDevicesRequest{
+		UserId: userId,
+	}); err != nil {
+		logger.Error("logout all devices error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, nil)
+}
+
+// ListSessionsHandler 获取已登录的设备
+func ListSessionsHandler(c *gin.Context) {
+	_, span := tracing.Tracer.Start(c.Request.Context(), "ListSessionsHandler")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "GateWay.ListSessions")
+
+	userId, err := request.GetUserId(c)
+	if err != nil {
+		logger.Warn("user not log in,but want to list sessions",
+			zap.Error(err))
+		str.Response(c, err, nil)
+		return
+	}
+	sessionId, _ := request.GetSessionId(c)
+	resp, err := client.ListSessions(c.Request.Context(), &userPb.ListSessionsRequest{
+		UserId:    userId,
+		SessionId: sessionId,
+	})
+	if err != nil {
+		logger.Error("list sessions error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		str.Response(c, err, nil)
+		return
+	}
+	str.Response(c, nil, map[string]interface{}{
+		"sessions": resp.Sessions,
 	})
 }
 
@@ -228,7 +361,7 @@ func GetUserInfoHandler(c *gin.Context) {
 	logger := logging.LogServiceWithTrace(span, "GateWay.GetUserInfo")
 
 	userIdStr := c.Param("id")
-	userId, err := strconv.ParseInt(userIdStr, 64, 10)
+	userId, err := strconv.ParseInt(userIdStr, 10, 64)
 	if err != nil || userId == 0 {
 		logger.Error("invalid param",
 			zap.Error(err),
@@ -245,9 +378,10 @@ func GetUserInfoHandler(c *gin.Context) {
 		ActorId: actorId,
 	})
 	if err != nil {
-		logger.Error("get community info service error",
+		logger.Error("get user info service error",
 			zap.Error(err))
 		str.Response(c, err, nil)
+		return
 	}
 
 	str.Response(c, nil, map[string]interface{}{
@@ -2,8 +2,8 @@ package middleware
 
 import (
 	"log"
-	"star/app/constant/settings"
 	"star/app/constant/str"
+	"star/app/storage/redis"
 	"star/app/utils/jwt"
 	"strings"
 
@@ -20,42 +20,80 @@ func JWTAuthHandler(c *gin.Context) {
 		return
 	}
 	//按空格分割取token
-	token := strings.Split(auth, " ")[1]
-	//解析token
-	claims, err := jwt.ParseToken(token)
+	parts := strings.SplitN(auth, " ", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		log.Println("授权字段格式错误")
+		str.Response(c, str.ErrInvalidAccessToken, nil)
+		c.Abort()
+		return
+	}
+	token := parts[1]
+	//解析token并校验登录会话
+	claims, err := parseSessionToken(c, token)
 	if err != nil {
 		log.Println("无效的token", err)
-		str.Response(c, str.ErrInvalidAccessToken, nil)
+		str.Response(c, err, nil)
 		c.Abort()
 		return
 	}
-	//将获取的用户id和用户名保存下来
+	//将获取的用户id和会话id保存下来
 	c.Set("userId", claims.UserID)
+	c.Set("sessionId", claims.SessionId)
 	c.Next()
 }
 
+// OptionalJWTAuthHandler 带有效token时保存用户id，未登录或token无效时按未登录继续处理
+func OptionalJWTAuthHandler(c *gin.Context) {
+	parts := strings.SplitN(c.Request.Header.Get("Authorization"), " ", 2)
+	if len(parts) == 2 {
+		if claims, err := parseSessionToken(c, parts[1]); err == nil {
+			c.Set("userId", claims.UserID)
+			c.Set("sessionId", claims.SessionId)
+		}
+	}
+	c.Next()
+}
+
+// parseSessionToken 解析accessToken，token所属的登录会话已退出或被撤销时无效
+func parseSessionToken(c *gin.Context, token string) (*jwt.MyClaims, error) {
+	claims, err := jwt.ParseAccessToken(token)
+	if err != nil || claims.SessionId == "" {
+		return nil, str.ErrInvalidAccessToken
+	}
+	userId, err := redis.GetSessionUserId(c.Request.Context(), claims.SessionId)
+	if err != nil {
+		log.Println("获取登录会话失败", err)
+		return nil, str.ErrServiceBusy
+	}
+	if userId != claims.UserID {
+		return nil, str.ErrSessionRevoked
+	}
+	return claims, nil
+}
+
+// AdminAuthHandler 管理接口的鉴权，接受管理员token或用户的accessToken，具体权限由RequirePermission或服务判断
 func AdminAuthHandler(c *gin.Context) {
 	//获取请求头中的授权字段
 	token := c.Request.Header.Get("adminToken")
 	if token == "" {
+		//拥有管理角色的用户使用自己的accessToken
+		if c.Request.Header.Get("Authorization") != "" {
+			JWTAuthHandler(c)
+			return
+		}
 		log.Println("授权字段为空")
 		str.Response(c, str.ErrNotLogin, nil)
 		c.Abort()
 		return
 	}
 	//解析token
-	claims, err := jwt.ParseToken(token)
+	claims, err := jwt.ParseAdminToken(token)
 	if err != nil {
 		log.Println("无效的token", err)
 		str.Response(c, str.ErrNotLogin, nil)
 		c.Abort()
 		return
 	}
-	if claims.UserID != settings.Conf.Id {
-		log.Println("错误的admin token")
-		str.Response(c, str.ErrNotLogin, nil)
-		c.Abort()
-		return
-	}
+	c.Set("userId", claims.UserID)
 	c.Next()
 }
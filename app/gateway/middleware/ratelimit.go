@@ -0,0 +1,138 @@
+package middleware
+
+import (
+	"log"
+	"math"
+	"net/http"
+	"star/app/constant/settings"
+	"star/app/constant/str"
+	"star/app/storage/redis"
+	"star/app/utils/request"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis_rate/v10"
+)
+
+// 限流策略名称，配置文件rate_limit.policies中可以按名称覆盖默认值
+const (
+	RateLimitGlobal  = "global"  //每个ip访问网关的总频率
+	RateLimitLogin   = "login"   //登录、刷新token
+	RateLimitCaptcha = "captcha" //图片验证码
+	RateLimitSms     = "sms"     //短信验证码
+	RateLimitWrite   = "write"   //发帖、评论、私信等写操作
+)
+
+// limitBy 限流的对象
+type limitBy int
+
+const (
+	byIP   limitBy = iota //按客户端ip
+	byUser                //按登录用户，未登录时按ip
+)
+
+type rateLimitPolicy struct {
+	by    limitBy
+	limit redis_rate.Limit
+}
+
+var defaultPolicies = map[string]rateLimitPolicy{
+	RateLimitGlobal:  {by: byIP, limit: redis_rate.Limit{Rate: 50, Burst: 100, Period: time.Second}},
+	RateLimitLogin:   {by: byIP, limit: redis_rate.Limit{Rate: 10, Burst: 10, Period: time.Minute}},
+	RateLimitCaptcha: {by: byIP, limit: redis_rate.Limit{Rate: 20, Burst: 20, Period: time.Minute}},
+	RateLimitSms:     {by: byIP, limit: redis_rate.Limit{Rate: 10, Burst: 10, Period: time.Hour}},
+	RateLimitWrite:   {by: byUser, limit: redis_rate.Limit{Rate: 30, Burst: 30, Period: time.Minute}},
+}
+
+// getPolicy 获取限流策略，配置中的频率覆盖默认值
+func getPolicy(name string) rateLimitPolicy {
+	policy := defaultPolicies[name]
+	conf := settings.Conf.RateLimitConfig.GetPolicy(name)
+	if conf == nil || conf.Rate <= 0 {
+		return policy
+	}
+	policy.limit.Rate = conf.Rate
+	policy.limit.Burst = conf.Burst
+	if policy.limit.Burst <= 0 {
+		policy.limit.Burst = conf.Rate
+	}
+	if conf.Period > 0 {
+		policy.limit.Period = time.Duration(conf.Period) * time.Second
+	}
+	return policy
+}
+
+// IPBanHandler 拒绝被暂时封禁的ip，redis不可用时放行
+func IPBanHandler(c *gin.Context) {
+	ttl, err := redis.GetIPBan(c.Request.Context(), c.ClientIP())
+	if err != nil {
+		log.Println("获取ip封禁状态失败", err)
+		c.Next()
+		return
+	}
+	if ttl > 0 {
+		c.Header("Retry-After", strconv.Itoa(ceilSeconds(ttl)))
+		str.ResponseStatus(c, http.StatusTooManyRequests, str.ErrIPBanned, nil)
+		c.Abort()
+		return
+	}
+	c.Next()
+}
+
+// RateLimit 按名称对应的策略限流，按用户限流时需要放在登录鉴权之后；
+// 超出频率的ip会被记录，时间窗口内超出次数过多时被暂时封禁，redis不可用时放行
+func RateLimit(name string) gin.HandlerFunc {
+	if _, ok := defaultPolicies[name]; !ok {
+		panic("unknown rate limit policy " + name)
+	}
+	return func(c *gin.Context) {
+		policy := getPolicy(name)
+		key := "RateLimit:" + name + ":ip:" + c.ClientIP()
+		if policy.by == byUser {
+			if userId, err := request.GetUserId(c); err == nil {
+				key = "RateLimit:" + name + ":user:" + strconv.FormatInt(userId, 10)
+			}
+		}
+		limiter := redis_rate.NewLimiter(redis.Client)
+		res, err := limiter.Allow(c.Request.Context(), key, policy.limit)
+		if err != nil {
+			log.Println("限流失败", err)
+			c.Next()
+			return
+		}
+		c.Header("X-RateLimit-Limit", strconv.Itoa(res.Limit.Rate))
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(res.Remaining))
+		c.Header("X-RateLimit-Reset", strconv.Itoa(ceilSeconds(res.ResetAfter)))
+		if res.Allowed > 0 {
+			c.Next()
+			return
+		}
+		c.Header("Retry-After", strconv.Itoa(ceilSeconds(res.RetryAfter)))
+		recordViolation(c)
+		str.ResponseStatus(c, http.StatusTooManyRequests, str.ErrRequestTooFrequently, nil)
+		c.Abort()
+	}
+}
+
+// recordViolation 记录ip超出频率，达到阈值时封禁ip
+func recordViolation(c *gin.Context) {
+	conf := settings.Conf.RateLimitConfig
+	banned, err := redis.RecordRateLimitViolation(c.Request.Context(), c.ClientIP(),
+		conf.GetBanWindow(), conf.GetBanThreshold(), conf.GetBanDuration())
+	if err != nil {
+		log.Println("记录限流失败", err)
+		return
+	}
+	if banned {
+		log.Println("ip多次超出请求频率，已暂时封禁", c.ClientIP())
+	}
+}
+
+// ceilSeconds 向上取整的秒数，用于Retry-After等响应头
+func ceilSeconds(d time.Duration) int {
+	if d <= 0 {
+		return 0
+	}
+	return int(math.Ceil(d.Seconds()))
+}
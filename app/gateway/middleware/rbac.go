@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"log"
+	"star/app/constant/str"
+	"star/app/gateway/client"
+	"star/app/utils/request"
+	"star/proto/admin/adminPb"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequirePermission 要求登录用户拥有全部权限，需要放在登录或管理员鉴权之后
+func RequirePermission(permissions ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userId, err := request.GetUserId(c)
+		if err != nil {
+			str.Response(c, str.ErrNotLogin, nil)
+			c.Abort()
+			return
+		}
+		resp, err := client.CheckPermission(c.Request.Context(), &adminPb.CheckPermissionRequest{
+			UserId:      userId,
+			Permissions: permissions,
+		})
+		if err != nil {
+			log.Println("校验权限失败", err)
+			str.Response(c, err, nil)
+			c.Abort()
+			return
+		}
+		if !resp.Allowed {
+			str.Response(c, str.ErrPermissionDenied, nil)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
@@ -7,3 +7,13 @@ type LikeAction struct {
 	ActionType uint32 `json:"action_type" binding:"required"`
 	Url        string `json:"url"`
 }
+
+// ReconcileFavorCount 校验点赞、收藏计数对账结构体，repair为空时只报告偏差
+type ReconcileFavorCount struct {
+	Kind      string  `json:"kind" binding:"omitempty,oneof=post_like comment_like post_collect"`
+	SourceIds []int64 `json:"sourceIds" binding:"max=1000"`
+	Full      bool    `json:"full"`
+	Cursor    int64   `json:"cursor"`
+	Limit     int64   `json:"limit" binding:"min=0,max=1000"`
+	Repair    string  `json:"repair" binding:"omitempty,oneof=mysql redis all"`
+}
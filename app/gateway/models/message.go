@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// SendGroupMessage 校验群聊消息发送结构体
+type SendGroupMessage struct {
+	CommunityId int64  `json:"communityId" binding:"required"`
+	Content     string `json:"content" binding:"required"`
+}
+
+// MarkGroupRead 校验群聊已读结构体
+type MarkGroupRead struct {
+	CommunityId int64 `json:"communityId" binding:"required"`
+	ChatId      int64 `json:"chatId" binding:"required"`
+}
+
+// MarkChatRead 校验私信已读结构体，lastMessageId为0时全部已读
+type MarkChatRead struct {
+	SenderId      int64 `json:"senderId" binding:"required"`
+	LastMessageId int64 `json:"lastMessageId"`
+}
+
+// MarkRemindRead 校验提醒已读结构体
+type MarkRemindRead struct {
+	Type string `json:"type" binding:"required,oneof=like mention reply system"`
+}
+
+// SendSystemMessage 校验系统通知发送结构体，publishTime为空时立即发布
+type SendSystemMessage struct {
+	Type         string    `json:"type" binding:"required,oneof=single all community users"`
+	Title        string    `json:"title" binding:"required,max=30"`
+	Content      string    `json:"content" binding:"required,max=255"`
+	RecipientId  int64     `json:"recipientId" binding:"required_if=Type single"`
+	CommunityId  int64     `json:"communityId" binding:"required_if=Type community"`
+	RecipientIds []int64   `json:"recipientIds" binding:"required_if=Type users,max=1000"`
+	PublishTime  time.Time `json:"publishTime"`
+}
+
+// RevokeSystemMessage 校验系统通知撤回结构体
+type RevokeSystemMessage struct {
+	SystemMessageId int64 `json:"systemMessageId" binding:"required"`
+}
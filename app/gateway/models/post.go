@@ -21,3 +21,13 @@ type GetCommunityPostByNewReply struct {
 	LastRelyTime string `json:"last_reply_time"`
 }
 
+
+type UpdatePost struct {
+	PostId  int64  `json:"post_id" binding:"required"`
+	Content string `json:"content"  binding:"required"`
+	IsScan  *bool  `json:"is_scan"` //为空时保持原来的可见性
+}
+
+type DeletePost struct {
+	PostId int64 `json:"post_id" binding:"required"`
+}
@@ -0,0 +1,8 @@
+package models
+
+// UserRole 授予或撤销的角色，社区角色需要communityId
+type UserRole struct {
+	UserId      int64  `json:"userId" binding:"required"`
+	RoleCode    string `json:"roleCode" binding:"required,oneof=super_admin admin community_leader community_moderator"`
+	CommunityId int64  `json:"communityId"`
+}
@@ -24,7 +24,9 @@ type LoginCaptcha struct {
 	Phone   string `json:"phone" binding:"required"`
 	Captcha string `json:"captcha" binding:"required"`
 }
+
+// Token 刷新token时只需要refreshToken，accessToken保留给已有的客户端
 type Token struct {
-	AccessToken  string `json:"accessToken" binding:"required"`
+	AccessToken  string `json:"accessToken"`
 	RefreshToken string `json:"refreshToken" binding:"required"`
 }
@@ -2,5 +2,17 @@ package models
 
 type PreUpload struct {
 	FileName string `form:"fileName"`
-	Chunks   uint32 `form:"chunks"`
+	Chunks   uint32 `form:"chunks" binding:"required"`
+}
+
+type UploadVideoChunk struct {
+	UploadId   string `form:"uploadId" binding:"required"`
+	ChunkIndex uint32 `form:"chunkIndex"`
+}
+
+type CompleteVideoUpload struct {
+	UploadId    string `json:"upload_id" binding:"required"`
+	CommunityId int64  `json:"community_id" binding:"required"`
+	Content     string `json:"content"`
+	IsScan      bool   `json:"is_scan"`
 }
@@ -0,0 +1,338 @@
+package router
+
+import (
+	"net/http"
+	"star/app/gateway/httpHandler"
+	"star/app/gateway/middleware"
+	"star/app/gateway/models"
+	models2 "star/app/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+const apiV1Prefix = "/api/v1"
+
+// access 接口的鉴权方式
+type access int
+
+const (
+	public   access = iota //不需要登录
+	optional               //登录时带上当前用户，未登录也可以访问
+	login                  //需要登录
+	admin                  //需要管理员token或用户的accessToken，权限由permissions或服务判断
+)
+
+// param 接口的路径参数或查询参数
+type param struct {
+	name     string
+	in       string //path、query
+	typ      string //integer、string、boolean
+	required bool
+	desc     string
+}
+
+func query(name, typ string, required bool, desc string) param {
+	return param{name: name, in: "query", typ: typ, required: required, desc: desc}
+}
+
+// route 一个接口的路由、鉴权方式和文档，注册路由和生成OpenAPI文档都使用这张表
+type route struct {
+	method  string
+	path    string
+	access  access
+	handler gin.HandlerFunc
+	rpc     string //接口调用的服务rpc，为空时不调用rpc
+	summary string
+	params  []param     //查询参数，路径参数从path中解析
+	body    interface{} //json请求体，按json和binding标签生成schema
+	form    interface{} //表单请求体，按form和binding标签生成schema
+	files   []string    //表单中上传的文件字段
+	file    bool        //直接返回文件内容
+	//需要拥有的全部权限
+	permissions []string
+	//接口单独的限流策略，在鉴权之后生效
+	limit string
+}
+
+// internalRPCs 只在服务之间调用、不通过网关暴露的rpc
+var internalRPCs = []string{
+	"feedPb.FeedService.QueryPostExist",
+	"feedPb.FeedService.QueryPosts",
+	"comment.CommentService.QueryCommentExist",
+	"messagePb.MessageService.SendRemindMessage",
+	"messagePb.MessageService.SendMessage",
+	"userPb.userService.GetUserExistInformation",
+	"userPb.userService.GetUserIdsByUsername",
+	"adminPb.AdminService.CheckPermission",
+}
+
+// InternalRPCs 返回不通过网关暴露的rpc
+func InternalRPCs() []string {
+	return append([]string(nil), internalRPCs...)
+}
+
+var v1Routes = []route{
+	//账号
+	{method: http.MethodPost, path: "/account/register", handler: httpHandler.SignupHandler,
+		rpc: "userPb.userService.Signup", summary: "注册", body: models.SignupUser{}},
+	{method: http.MethodPost, path: "/account/checkCode", limit: middleware.RateLimitCaptcha, handler: httpHandler.GetCaptchaHandler,
+		summary: "获取图片验证码"},
+	{method: http.MethodPost, path: "/account/send", limit: middleware.RateLimitSms, handler: httpHandler.SendSetupHandler,
+		summary: "发送注册短信验证码", params: []param{query("phone", "string", true, "手机号")}},
+	{method: http.MethodPost, path: "/account/sendLogin", limit: middleware.RateLimitSms, handler: httpHandler.SendLoginHandler,
+		summary: "发送登录短信验证码", params: []param{query("phone", "string", true, "手机号")}},
+	{method: http.MethodPost, path: "/account/login", limit: middleware.RateLimitLogin, handler: httpHandler.LoginHandler,
+		rpc: "userPb.userService.LoginPassword", summary: "密码登录", body: models.LoginPassword{}},
+	{method: http.MethodPost, path: "/account/loginCaptcha", limit: middleware.RateLimitLogin, handler: httpHandler.LoginWithCaptchaHandler,
+		rpc: "userPb.userService.LoginCaptcha", summary: "短信验证码登录", body: models.LoginCaptcha{}},
+	{method: http.MethodPost, path: "/account/autoLogin", limit: middleware.RateLimitLogin, handler: httpHandler.AutoLoginHandler,
+		rpc: "userPb.userService.RefreshToken", summary: "自动登录，返回轮换后的token", body: models.Token{}},
+	{method: http.MethodPost, path: "/account/refreshToken", limit: middleware.RateLimitLogin, handler: httpHandler.RefreshTokenHandler,
+		rpc: "userPb.userService.RefreshToken", summary: "轮换refresh token", body: models.Token{}},
+	{method: http.MethodPost, path: "/account/logout", access: login, handler: httpHandler.LogoutHandler,
+		rpc: "userPb.userService.Logout", summary: "退出当前设备的登录"},
+	{method: http.MethodPost, path: "/account/logoutAll", access: login, handler: httpHandler.LogoutAllDevicesHandler,
+		rpc: "userPb.userService.LogoutAllDevices", summary: "退出所有设备的登录"},
+	{method: http.MethodGet, path: "/account/sessions", access: login, handler: httpHandler.ListSessionsHandler,
+		rpc: "userPb.userService.ListSessions", summary: "已登录的设备"},
+	{method: http.MethodDelete, path: "/account/sessions/:id", access: login, handler: httpHandler.LogoutSessionHandler,
+		rpc: "userPb.userService.Logout", summary: "退出指定设备的登录"},
+
+	//用户
+	{method: http.MethodGet, path: "/users/:id", access: optional, handler: httpHandler.GetUserInfoHandler,
+		rpc: "userPb.userService.GetUserInfo", summary: "用户信息"},
+	{method: http.MethodGet, path: "/users/:id/posts", access: optional, handler: httpHandler.ListPostHandler,
+		rpc: "publishPb.PublishService.ListPost", summary: "用户发布的帖子"},
+	{method: http.MethodGet, path: "/users/:id/posts/count", handler: httpHandler.CountPostHandler,
+		rpc: "publishPb.PublishService.CountPost", summary: "用户发布的帖子数"},
+	{method: http.MethodGet, path: "/users/:id/likes/count", handler: httpHandler.GetUserLikeCountHandler,
+		rpc: "LikeService.GetUserLikeCount", summary: "用户点赞的帖子数"},
+	{method: http.MethodGet, path: "/users/:id/likes/received", handler: httpHandler.GetUserTotalLikeHandler,
+		rpc: "LikeService.GetUserTotalLike", summary: "用户获得的点赞数"},
+	{method: http.MethodGet, path: "/users/:id/collects/count", handler: httpHandler.GetUserCollectCountHandler,
+		rpc: "collectPb.CollectService.GetUserCollectCount", summary: "用户收藏的帖子数"},
+	{method: http.MethodGet, path: "/users/:id/follows/count", handler: httpHandler.CountFollowHandler,
+		rpc: "relationPb.RelationService.CountFollow", summary: "用户的关注数"},
+	{method: http.MethodGet, path: "/users/:id/fans/count", handler: httpHandler.CountFansHandler,
+		rpc: "relationPb.RelationService.CountFans", summary: "用户的粉丝数"},
+	{method: http.MethodGet, path: "/users/:id/communities/count", handler: httpHandler.CountCommunityFollowHandler,
+		rpc: "communityPb.community.CountCommunityFollow", summary: "用户关注的社区数"},
+	{method: http.MethodGet, path: "/users/:id/follow", access: login, handler: httpHandler.IsFollowHandler,
+		rpc: "relationPb.RelationService.IsFollow", summary: "是否关注了用户"},
+	{method: http.MethodPost, path: "/users/:id/follow", access: login, handler: httpHandler.FollowHandler,
+		rpc: "relationPb.RelationService.Follow", summary: "关注用户"},
+	{method: http.MethodDelete, path: "/users/:id/follow", access: login, handler: httpHandler.UnFollowHandler,
+		rpc: "relationPb.RelationService.UnFollow", summary: "取消关注用户"},
+
+	//当前用户
+	{method: http.MethodGet, path: "/me/follows", access: login, handler: httpHandler.GetFollowListHandler,
+		rpc: "relationPb.RelationService.GetFollowList", summary: "关注列表"},
+	{method: http.MethodGet, path: "/me/fans", access: login, handler: httpHandler.GetFansListHandler,
+		rpc: "relationPb.RelationService.GetFansList", summary: "粉丝列表"},
+	{method: http.MethodGet, path: "/me/likes", access: login, handler: httpHandler.LikeListHandler,
+		rpc: "LikeService.LikeList", summary: "点赞的帖子"},
+	{method: http.MethodGet, path: "/me/collects", access: login, handler: httpHandler.CollectListHandler,
+		rpc: "collectPb.CollectService.CollectList", summary: "收藏的帖子"},
+	{method: http.MethodGet, path: "/me/communities", access: login, handler: httpHandler.GetFollowCommunityListHandler,
+		rpc: "communityPb.community.GetFollowCommunityList", summary: "关注的社区"},
+
+	//社区
+	{method: http.MethodGet, path: "/categories", handler: httpHandler.LoadCategoryListHandler,
+		rpc: "adminPb.AdminService.LoadCategoryList", summary: "社区分类"},
+	{method: http.MethodPost, path: "/communities", access: login, limit: middleware.RateLimitWrite, handler: httpHandler.CreateCommunityHandler,
+		rpc: "communityPb.community.CreateCommunity", summary: "创建社区", body: models2.Community{}},
+	{method: http.MethodGet, path: "/communities/:id", handler: httpHandler.GetCommunityInfoHandler,
+		rpc: "communityPb.community.GetCommunityInfo", summary: "社区信息"},
+	{method: http.MethodGet, path: "/communities/:id/follow", access: login, handler: httpHandler.IsFollowCommunityHandler,
+		rpc: "communityPb.community.IsFollowCommunity", summary: "是否关注了社区"},
+	{method: http.MethodPost, path: "/communities/:id/follow", access: login, handler: httpHandler.FollowCommunityHandler,
+		rpc: "communityPb.community.FollowCommunity", summary: "关注社区"},
+	{method: http.MethodDelete, path: "/communities/:id/follow", access: login, handler: httpHandler.UnFollowCommunityHandler,
+		rpc: "communityPb.community.UnFollowCommunity", summary: "取消关注社区"},
+
+	//帖子
+	{method: http.MethodPost, path: "/feed/community/time", access: optional, handler: httpHandler.GetCommunityPostByTimeHandler,
+		rpc: "feedPb.FeedService.GetCommunityPostByTime", summary: "社区帖子，按发布时间排序", body: models.GetCommunityPost{}},
+	{method: http.MethodPost, path: "/feed/community/reply", access: optional, handler: httpHandler.GetCommunityPostByNewReplyHandler,
+		rpc: "feedPb.FeedService.GetCommunityPostByNewReply", summary: "社区帖子，按最新回复排序", body: models.GetCommunityPostByNewReply{}},
+	{method: http.MethodGet, path: "/feed/following", access: login, handler: httpHandler.GetPostByNewRelationHandler,
+		rpc: "feedPb.FeedService.GetPostByRelation", summary: "关注的用户发布的帖子", params: []param{
+			query("last_post_id", "integer", false, "上一页最后一个帖子id"),
+			query("limit", "integer", false, "每页数量"),
+		}},
+	{method: http.MethodPost, path: "/posts", access: login, limit: middleware.RateLimitWrite, handler: httpHandler.CreatePostHandler,
+		rpc: "publishPb.PublishService.CreatePost", summary: "发布帖子", body: models.CreatePost{}},
+	{method: http.MethodPost, path: "/posts/update", access: login, limit: middleware.RateLimitWrite, handler: httpHandler.UpdatePostHandler,
+		rpc: "publishPb.PublishService.UpdatePost", summary: "修改帖子", body: models.UpdatePost{}},
+	{method: http.MethodPost, path: "/posts/delete", access: login, handler: httpHandler.DeletePostHandler,
+		rpc: "publishPb.PublishService.DeletePost", summary: "删除帖子", body: models.DeletePost{}},
+
+	//点赞、收藏
+	{method: http.MethodPost, path: "/likes", access: login, handler: httpHandler.LikeActionHandler,
+		rpc: "LikeService.LikeAction", summary: "点赞、取消点赞", body: models.LikeAction{}},
+	{method: http.MethodGet, path: "/likes/status", access: login, handler: httpHandler.IsLikeHandler,
+		rpc: "LikeService.IsLike", summary: "是否点赞", params: []param{
+			query("sourceId", "integer", true, "帖子或评论id"),
+			query("sourceType", "integer", true, "1 帖子 2 评论"),
+		}},
+	{method: http.MethodGet, path: "/likes/count", handler: httpHandler.GetLikeCountHandler,
+		rpc: "LikeService.GetLikeCount", summary: "点赞数", params: []param{
+			query("sourceId", "integer", true, "帖子或评论id"),
+			query("sourceType", "integer", true, "1 帖子 2 评论"),
+		}},
+	{method: http.MethodPost, path: "/collects", access: login, handler: httpHandler.CollectActionHandler,
+		rpc: "collectPb.CollectService.CollectAction", summary: "收藏、取消收藏", body: models.CollectAction{}},
+	{method: http.MethodGet, path: "/collects/status", access: login, handler: httpHandler.IsCollectHandler,
+		rpc: "collectPb.CollectService.IsCollect", summary: "是否收藏", params: []param{
+			query("postId", "integer", true, "帖子id"),
+		}},
+	{method: http.MethodGet, path: "/collects/count", handler: httpHandler.GetCollectCountHandler,
+		rpc: "collectPb.CollectService.GetCollectCount", summary: "收藏数", params: []param{
+			query("postId", "integer", true, "帖子id"),
+		}},
+
+	//评论
+	{method: http.MethodPost, path: "/comments", access: login, limit: middleware.RateLimitWrite, handler: httpHandler.PostComment,
+		rpc: "comment.CommentService.PostComment", summary: "发表评论", body: models.PostComment{}},
+	{method: http.MethodDelete, path: "/comments/:id", access: login, handler: httpHandler.DeleteComment,
+		rpc: "comment.CommentService.DeleteComment", summary: "删除自己的评论"},
+	{method: http.MethodGet, path: "/comments", handler: httpHandler.GetComments,
+		rpc: "comment.CommentService.GetComments", summary: "帖子的评论", params: []param{
+			query("postId", "integer", true, "帖子id"),
+			query("sort", "integer", false, "0 按热度 1 按时间"),
+			query("cursor", "string", false, "分页游标，首页为空"),
+			query("page_size", "integer", false, "每页评论数"),
+			query("reply_size", "integer", false, "每条评论预加载的回复数"),
+		}},
+	{method: http.MethodGet, path: "/comments/replies", handler: httpHandler.GetReplies,
+		rpc: "comment.CommentService.GetReplies", summary: "评论的回复", params: []param{
+			query("commentId", "integer", true, "评论id"),
+			query("cursor", "string", false, "分页游标，首页为空"),
+			query("page_size", "integer", false, "每页回复数"),
+		}},
+	{method: http.MethodGet, path: "/comments/count", handler: httpHandler.CountCommentHandler,
+		rpc: "comment.CommentService.CountComment", summary: "帖子的评论数", params: []param{
+			query("postId", "integer", true, "帖子id"),
+		}},
+
+	//文件
+	{method: http.MethodPost, path: "/files/preUploadVideo", access: login, handler: httpHandler.PreUploadVideosHandler,
+		rpc: "publishPb.PublishService.PreUploadVideos", summary: "创建视频分片上传任务", form: models.PreUpload{}},
+	{method: http.MethodPost, path: "/files/uploadVideoChunk", access: login, handler: httpHandler.UploadVideoChunkHandler,
+		rpc: "publishPb.PublishService.UploadVideoChunk", summary: "上传视频分片", form: models.UploadVideoChunk{}, files: []string{"chunk"}},
+	{method: http.MethodPost, path: "/files/completeVideoUpload", access: login, handler: httpHandler.CompleteVideoUploadHandler,
+		rpc: "publishPb.PublishService.CompleteVideoUpload", summary: "合并视频分片并发布帖子", body: models.CompleteVideoUpload{}},
+	{method: http.MethodGet, path: "/files/local/*key", handler: httpHandler.LocalFileHandler,
+		summary: "本地存储的文件", file: true, params: []param{
+			query("e", "string", false, "签名过期时间"),
+			query("token", "string", false, "签名"),
+		}},
+
+	//消息
+	{method: http.MethodGet, path: "/messages/count", access: login, handler: httpHandler.ListMessageCountHandler,
+		rpc: "messagePb.MessageService.ListMessageCount", summary: "未读消息数"},
+	{method: http.MethodGet, path: "/messages/chats", access: login, handler: httpHandler.GetChatListHandler,
+		rpc: "messagePb.MessageService.GetChatList", summary: "私信会话列表"},
+	{method: http.MethodGet, path: "/messages/chat", access: login, handler: httpHandler.LoadMessageHandler,
+		rpc: "messagePb.MessageService.LoadMessage", summary: "与用户的私信", params: []param{
+			query("userId", "integer", true, "对方的用户id"),
+			query("privateChatId", "integer", false, "私信会话id"),
+			query("lastMsgTime", "string", false, "上一页最早的消息时间，为空时从最新的消息开始"),
+		}},
+	{method: http.MethodPost, path: "/messages/chat/read", access: login, handler: httpHandler.MarkChatReadHandler,
+		rpc: "messagePb.MessageService.MarkChatRead", summary: "私信已读", body: models.MarkChatRead{}},
+	{method: http.MethodPost, path: "/messages/whisper/:userId", access: login, limit: middleware.RateLimitWrite, handler: httpHandler.SendPrivateMessageHandler,
+		rpc: "messagePb.MessageService.SendPrivateMessage", summary: "发送私信", params: []param{
+			query("content", "string", true, "私信内容"),
+		}},
+	{method: http.MethodGet, path: "/messages/reminds", access: login, handler: httpHandler.ListRemindMessagesHandler,
+		rpc: "messagePb.MessageService.ListRemindMessages", summary: "提醒消息", params: []param{
+			query("type", "string", false, "like、mention、reply"),
+			query("cursor", "integer", false, "分页游标"),
+			query("limit", "integer", false, "每页数量"),
+		}},
+	{method: http.MethodPost, path: "/messages/remind/read", access: login, handler: httpHandler.MarkRemindReadHandler,
+		rpc: "messagePb.MessageService.MarkRemindRead", summary: "提醒已读", body: models.MarkRemindRead{}},
+	{method: http.MethodGet, path: "/messages/system", access: login, handler: httpHandler.ListSystemMessagesHandler,
+		rpc: "messagePb.MessageService.ListSystemMessages", summary: "系统通知", params: []param{
+			query("cursor", "integer", false, "分页游标"),
+			query("limit", "integer", false, "每页数量"),
+		}},
+	{method: http.MethodPost, path: "/messages/read/all", access: login, handler: httpHandler.MarkAllReadHandler,
+		rpc: "messagePb.MessageService.MarkAllRead", summary: "全部已读"},
+	{method: http.MethodPost, path: "/groups/message", access: login, limit: middleware.RateLimitWrite, handler: httpHandler.SendGroupMessageHandler,
+		rpc: "messagePb.MessageService.SendGroupMessage", summary: "发送群聊消息", body: models.SendGroupMessage{}},
+	{method: http.MethodGet, path: "/groups/messages", access: login, handler: httpHandler.LoadGroupMessageHandler,
+		rpc: "messagePb.MessageService.LoadGroupMessage", summary: "群聊消息", params: []param{
+			query("communityId", "integer", true, "社区id"),
+			query("lastChatId", "integer", false, "上一页最早的消息id"),
+			query("limit", "integer", false, "每页数量"),
+		}},
+	{method: http.MethodGet, path: "/groups/unread", access: login, handler: httpHandler.GetGroupUnreadCountHandler,
+		rpc: "messagePb.MessageService.GetGroupUnreadCount", summary: "群聊未读数"},
+	{method: http.MethodPost, path: "/groups/read", access: login, handler: httpHandler.MarkGroupReadHandler,
+		rpc: "messagePb.MessageService.MarkGroupRead", summary: "群聊已读", body: models.MarkGroupRead{}},
+
+	//管理员
+	{method: http.MethodPost, path: "/admin/account/checkCode", limit: middleware.RateLimitCaptcha, handler: httpHandler.GetCaptchaHandler,
+		summary: "管理员获取图片验证码"},
+	{method: http.MethodPost, path: "/admin/account/login", limit: middleware.RateLimitLogin, handler: httpHandler.LoginAdminHandler,
+		summary: "管理员登录", body: models.LoginPassword{}},
+	{method: http.MethodPost, path: "/admin/category/loadCategory", access: admin, permissions: []string{models2.PermCategoryManage}, handler: httpHandler.LoadCategoryListHandler,
+		rpc: "adminPb.AdminService.LoadCategoryList", summary: "社区分类"},
+	{method: http.MethodPost, path: "/admin/category/delCategory", access: admin, permissions: []string{models2.PermCategoryManage}, handler: httpHandler.DelCategoryHandler,
+		rpc: "adminPb.AdminService.DelCategory", summary: "删除分类", form: struct {
+			Id int64 `form:"categoryId"`
+		}{}},
+	{method: http.MethodPost, path: "/admin/category/saveCategory", access: admin, permissions: []string{models2.PermCategoryManage}, handler: httpHandler.SaveCategoryHandler,
+		rpc: "adminPb.AdminService.SaveCategory", summary: "新增或修改分类", form: models.Category{}},
+	{method: http.MethodPost, path: "/admin/category/changeSort", access: admin, permissions: []string{models2.PermCategoryManage}, handler: httpHandler.ChangeSortHandler,
+		rpc: "adminPb.AdminService.ChangeSort", summary: "调整分类顺序", form: models.ChangeSort{}},
+	{method: http.MethodPost, path: "/admin/file/uploadImage", access: admin, permissions: []string{models2.PermFileUpload}, handler: httpHandler.FileUploadHandler,
+		summary: "上传图片", files: []string{"file"}},
+	{method: http.MethodPost, path: "/admin/message/system/send", access: admin, permissions: []string{models2.PermSystemMessage}, handler: httpHandler.SendSystemMessageHandler,
+		rpc: "messagePb.MessageService.SendSystemMessage", summary: "发送系统通知", body: models.SendSystemMessage{}},
+	{method: http.MethodPost, path: "/admin/message/system/revoke", access: admin, permissions: []string{models2.PermSystemMessage}, handler: httpHandler.RevokeSystemMessageHandler,
+		rpc: "messagePb.MessageService.RevokeSystemMessage", summary: "撤回系统通知", body: models.RevokeSystemMessage{}},
+	{method: http.MethodPost, path: "/admin/favor/reconcile", access: admin, permissions: []string{models2.PermFavorReconcile}, handler: httpHandler.ReconcileFavorCountHandler,
+		rpc: "LikeService.ReconcileFavorCount", summary: "点赞、收藏计数对账", body: models.ReconcileFavorCount{}},
+	{method: http.MethodPost, path: "/admin/roles", access: admin, handler: httpHandler.GrantRoleHandler,
+		rpc: "adminPb.AdminService.GrantRole", summary: "授予角色", body: models.UserRole{}},
+	{method: http.MethodPost, path: "/admin/roles/revoke", access: admin, handler: httpHandler.RevokeRoleHandler,
+		rpc: "adminPb.AdminService.RevokeRole", summary: "撤销角色", body: models.UserRole{}},
+	{method: http.MethodGet, path: "/admin/users/:id/roles", access: admin, handler: httpHandler.ListUserRolesHandler,
+		rpc: "adminPb.AdminService.ListUserRoles", summary: "用户的角色"},
+}
+
+// routeHandlers 按鉴权方式、限流策略和需要的权限返回接口前的中间件，
+// 限流放在鉴权之后以便按用户限流，放在权限校验之前以免被刷rpc
+func routeHandlers(r route) []gin.HandlerFunc {
+	var handlers []gin.HandlerFunc
+	switch r.access {
+	case optional:
+		handlers = []gin.HandlerFunc{middleware.OptionalJWTAuthHandler}
+	case login:
+		handlers = []gin.HandlerFunc{middleware.JWTAuthHandler}
+	case admin:
+		handlers = []gin.HandlerFunc{middleware.AdminAuthHandler}
+	}
+	if r.limit != "" {
+		handlers = append(handlers, middleware.RateLimit(r.limit))
+	}
+	if len(r.permissions) > 0 {
+		handlers = append(handlers, middleware.RequirePermission(r.permissions...))
+	}
+	return handlers
+}
+
+// setupV1 注册/api/v1下的全部接口和OpenAPI文档
+func setupV1(v *gin.Engine) {
+	g := v.Group(apiV1Prefix)
+	for _, r := range v1Routes {
+		g.Handle(r.method, r.path, append(routeHandlers(r), r.handler)...)
+	}
+	spec := newOpenAPI(v1Routes)
+	g.GET("/openapi.json", func(c *gin.Context) {
+		c.JSON(http.StatusOK, spec)
+	})
+}
@@ -2,53 +2,74 @@ package router
 
 import (
 	"github.com/gin-gonic/gin"
+	"log"
+	"star/app/constant/settings"
 	"star/app/gateway/httpHandler"
 	"star/app/gateway/middleware"
+	"star/app/models"
 )
 
 func Setup() *gin.Engine {
 	v := gin.New()
+	// 限流和封禁按ClientIP，只有来自可信代理的请求才使用X-Forwarded-For，否则客户端可以伪造ip
+	if err := v.SetTrustedProxies(settings.Conf.GinConfig.GetTrustedProxies()); err != nil {
+		log.Println("可信代理配置错误，不信任任何代理", err)
+		_ = v.SetTrustedProxies(nil)
+	}
 	// v.Use(logger.GinLogger(), logger.GinRecovery(true))
+	// 拒绝被暂时封禁的ip，并限制每个ip的总请求频率
+	v.Use(middleware.IPBanHandler, middleware.RateLimit(middleware.RateLimitGlobal))
+	// 版本化的接口，新客户端使用/api/v1，下面未带版本的路由保留给已有的客户端
+	setupV1(v)
+	// 验证token的公钥
+	v.GET("/.well-known/jwks.json", httpHandler.JWKSHandler)
 	// 用户相关路由
 	v1 := v.Group("/account")
 	{
 		v1.POST("/register", httpHandler.SignupHandler)
-		v1.POST("/checkCode", httpHandler.GetCaptchaHandler)
-		v1.POST("/login", httpHandler.LoginHandler)
-		v1.POST("/autoLogin", httpHandler.AutoLoginHandler)
-		v1.POST("/send", httpHandler.SendSetupHandler)
+		v1.POST("/checkCode", middleware.RateLimit(middleware.RateLimitCaptcha), httpHandler.GetCaptchaHandler)
+		v1.POST("/login", middleware.RateLimit(middleware.RateLimitLogin), httpHandler.LoginHandler)
+		v1.POST("/autoLogin", middleware.RateLimit(middleware.RateLimitLogin), httpHandler.AutoLoginHandler)
+		v1.POST("/send", middleware.RateLimit(middleware.RateLimitSms), httpHandler.SendSetupHandler)
 	}
-	v.POST("/refreshToken", httpHandler.RefreshTokenHandler)
+	v.POST("/refreshToken", middleware.RateLimit(middleware.RateLimitLogin), httpHandler.RefreshTokenHandler)
 	v2 := v.Group("/admin")
 	{
-		v2.POST("/account/checkCode", httpHandler.GetCaptchaHandler)
-		v2.POST("/account/login", httpHandler.LoginAdminHandler)
+		v2.POST("/account/checkCode", middleware.RateLimit(middleware.RateLimitCaptcha), httpHandler.GetCaptchaHandler)
+		v2.POST("/account/login", middleware.RateLimit(middleware.RateLimitLogin), httpHandler.LoginAdminHandler)
 
 		v3 := v2.Use(middleware.AdminAuthHandler)
 		{
-			v3.POST("/category/loadCategory", httpHandler.LoadCategoryListHandler)
-			v3.POST("/category/delCategory", httpHandler.DelCategoryHandler)
-			v3.POST("/category/saveCategory", httpHandler.SaveCategoryHandler)
-			v3.POST("/category/changeSort", httpHandler.ChangeSortHandler)
-			v3.POST("/file/uploadImage", httpHandler.FileUploadHandler)
+			manageCategory := middleware.RequirePermission(models.PermCategoryManage)
+			v3.POST("/category/loadCategory", manageCategory, httpHandler.LoadCategoryListHandler)
+			v3.POST("/category/delCategory", manageCategory, httpHandler.DelCategoryHandler)
+			v3.POST("/category/saveCategory", manageCategory, httpHandler.SaveCategoryHandler)
+			v3.POST("/category/changeSort", manageCategory, httpHandler.ChangeSortHandler)
+			v3.POST("/file/uploadImage", middleware.RequirePermission(models.PermFileUpload), httpHandler.FileUploadHandler)
+			v3.POST("/message/system/send", middleware.RequirePermission(models.PermSystemMessage), httpHandler.SendSystemMessageHandler)
+			v3.POST("/message/system/revoke", middleware.RequirePermission(models.PermSystemMessage), httpHandler.RevokeSystemMessageHandler)
+			v3.POST("/favor/reconcile", middleware.RequirePermission(models.PermFavorReconcile), httpHandler.ReconcileFavorCountHandler)
 		}
 	}
 	v.POST("/category/loadAllCategory", httpHandler.LoadCategoryListHandler)
 	v.POST("file/preUploadVideo", middleware.JWTAuthHandler, httpHandler.PreUploadVideosHandler)
-
-	//
-
-	//使用登录鉴权中间件
-	//v.Use(middleware.JWTAuthHandler)
-	//{
-	//	// 评论相关路由
-	//	v.POST("/comment", httpHandler.PostComment)
-	//	v.DELETE("/comment/:id", httpHandler.DeleteComment)
-	//	v.GET("/comments", httpHandler.GetComments)
-	//
-	//	v.GET("/whisper", httpHandler.ListMessageCountHandler)
-	//	v.GET("/whisper/:userId", httpHandler.SendPrivateMessageHandler)
-	//}
+	v.POST("file/uploadVideoChunk", middleware.JWTAuthHandler, httpHandler.UploadVideoChunkHandler)
+	v.POST("file/completeVideoUpload", middleware.JWTAuthHandler, httpHandler.CompleteVideoUploadHandler)
+	v.GET("file/local/*key", httpHandler.LocalFileHandler)
+	v.POST("/post/update", middleware.JWTAuthHandler, middleware.RateLimit(middleware.RateLimitWrite), httpHandler.UpdatePostHandler)
+	v.POST("/post/delete", middleware.JWTAuthHandler, httpHandler.DeletePostHandler)
+	v.GET("/comments", httpHandler.GetComments)
+	v.GET("/comments/replies", httpHandler.GetReplies)
+	v.POST("/group/message", middleware.JWTAuthHandler, middleware.RateLimit(middleware.RateLimitWrite), httpHandler.SendGroupMessageHandler)
+	v.GET("/group/messages", middleware.JWTAuthHandler, httpHandler.LoadGroupMessageHandler)
+	v.GET("/group/unread", middleware.JWTAuthHandler, httpHandler.GetGroupUnreadCountHandler)
+	v.POST("/group/read", middleware.JWTAuthHandler, httpHandler.MarkGroupReadHandler)
+	v.GET("/message/count", middleware.JWTAuthHandler, httpHandler.ListMessageCountHandler)
+	v.POST("/message/chat/read", middleware.JWTAuthHandler, httpHandler.MarkChatReadHandler)
+	v.POST("/message/remind/read", middleware.JWTAuthHandler, httpHandler.MarkRemindReadHandler)
+	v.POST("/message/read/all", middleware.JWTAuthHandler, httpHandler.MarkAllReadHandler)
+	v.GET("/message/reminds", middleware.JWTAuthHandler, httpHandler.ListRemindMessagesHandler)
+	v.GET("/message/system", middleware.JWTAuthHandler, httpHandler.ListSystemMessagesHandler)
 
 	return v
 }
@@ -0,0 +1,305 @@
+package router
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const openAPIVersion = "3.0.3"
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// newOpenAPI 根据路由表生成OpenAPI文档，x-rpc为接口调用的服务rpc
+func newOpenAPI(routes []route) map[string]interface{} {
+	paths := make(map[string]interface{})
+	for _, r := range routes {
+		path := openAPIPath(r.path)
+		item, ok := paths[path].(map[string]interface{})
+		if !ok {
+			item = make(map[string]interface{})
+			paths[path] = item
+		}
+		item[strings.ToLower(r.method)] = newOperation(r)
+	}
+	paths["/openapi.json"] = map[string]interface{}{
+		"get": map[string]interface{}{
+			"tags":    []string{"docs"},
+			"summary": "OpenAPI文档",
+			"responses": map[string]interface{}{
+				"200": map[string]interface{}{"description": "OpenAPI文档"},
+			},
+		},
+	}
+	return map[string]interface{}{
+		"openapi": openAPIVersion,
+		"info": map[string]interface{}{
+			"title":   "star gateway",
+			"version": "v1",
+		},
+		"servers": []interface{}{
+			map[string]interface{}{"url": apiV1Prefix},
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"securitySchemes": map[string]interface{}{
+				"bearerAuth": map[string]interface{}{
+					"type":         "http",
+					"scheme":       "bearer",
+					"bearerFormat": "JWT",
+				},
+				"adminToken": map[string]interface{}{
+					"type": "apiKey",
+					"in":   "header",
+					"name": "adminToken",
+				},
+			},
+			"schemas": map[string]interface{}{
+				//所有接口返回statusCode和statusMsg，其余字段随接口不同
+				"Response": map[string]interface{}{
+					"type":     "object",
+					"required": []string{"statusCode", "statusMsg"},
+					"properties": map[string]interface{}{
+						"statusCode": map[string]interface{}{"type": "integer"},
+						"statusMsg":  map[string]interface{}{"type": "string"},
+					},
+					"additionalProperties": true,
+				},
+			},
+		},
+	}
+}
+
+// openAPIPath 将gin的路径参数:id和*key转换为{id}和{key}
+func openAPIPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func newOperation(r route) map[string]interface{} {
+	op := map[string]interface{}{
+		"tags":    []string{strings.Split(strings.TrimPrefix(r.path, "/"), "/")[0]},
+		"summary": r.summary,
+	}
+	if r.rpc != "" {
+		op["x-rpc"] = r.rpc
+	}
+
+	var params []interface{}
+	for _, segment := range strings.Split(r.path, "/") {
+		if !strings.HasPrefix(segment, ":") && !strings.HasPrefix(segment, "*") {
+			continue
+		}
+		typ := "string"
+		if strings.HasSuffix(strings.ToLower(segment), "id") {
+			typ = "integer"
+		}
+		params = append(params, map[string]interface{}{
+			"name":     segment[1:],
+			"in":       "path",
+			"required": true,
+			"schema":   map[string]interface{}{"type": typ},
+		})
+	}
+	for _, p := range r.params {
+		params = append(params, map[string]interface{}{
+			"name":        p.name,
+			"in":          p.in,
+			"required":    p.required,
+			"description": p.desc,
+			"schema":      map[string]interface{}{"type": p.typ},
+		})
+	}
+	if len(params) > 0 {
+		op["parameters"] = params
+	}
+
+	switch {
+	case r.body != nil:
+		op["requestBody"] = map[string]interface{}{
+			"required": true,
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{
+					"schema": schemaOf(reflect.TypeOf(r.body), "json"),
+				},
+			},
+		}
+	case r.form != nil || len(r.files) > 0:
+		schema := map[string]interface{}{
+			"type":       "object",
+			"properties": map[string]interface{}{},
+		}
+		if r.form != nil {
+			schema = schemaOf(reflect.TypeOf(r.form), "form")
+		}
+		for _, name := range r.files {
+			schema["properties"].(map[string]interface{})[name] = map[string]interface{}{
+				"type":   "string",
+				"format": "binary",
+			}
+			schema["required"] = append(requiredOf(schema), name)
+		}
+		op["requestBody"] = map[string]interface{}{
+			"required": true,
+			"content": map[string]interface{}{
+				"multipart/form-data": map[string]interface{}{"schema": schema},
+			},
+		}
+	}
+
+	switch r.access {
+	case optional:
+		op["security"] = []interface{}{
+			map[string]interface{}{},
+			map[string]interface{}{"bearerAuth": []string{}},
+		}
+	case login:
+		op["security"] = []interface{}{map[string]interface{}{"bearerAuth": []string{}}}
+	case admin:
+		op["security"] = []interface{}{
+			map[string]interface{}{"adminToken": []string{}},
+			map[string]interface{}{"bearerAuth": []string{}},
+		}
+	}
+	if len(r.permissions) > 0 {
+		op["x-permissions"] = r.permissions
+	}
+	if r.limit != "" {
+		op["x-rate-limit"] = r.limit
+	}
+
+	response := map[string]interface{}{
+		"description": "statusCode为0时成功，否则statusMsg为错误信息",
+		"content": map[string]interface{}{
+			"application/json": map[string]interface{}{
+				"schema": map[string]interface{}{"$ref": "#/components/schemas/Response"},
+			},
+		},
+	}
+	if r.file {
+		response = map[string]interface{}{
+			"description": "文件内容",
+			"content": map[string]interface{}{
+				"application/octet-stream": map[string]interface{}{
+					"schema": map[string]interface{}{"type": "string", "format": "binary"},
+				},
+			},
+		}
+	}
+	op["responses"] = map[string]interface{}{
+		strconv.Itoa(http.StatusOK): response,
+		//所有接口都有按ip的总频率限制，被限流或ip被暂时封禁时返回429
+		strconv.Itoa(http.StatusTooManyRequests): map[string]interface{}{
+			"description": "请求过于频繁，Retry-After为需要等待的秒数",
+			"headers": map[string]interface{}{
+				"Retry-After": map[string]interface{}{"schema": map[string]interface{}{"type": "integer"}},
+			},
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{
+					"schema": map[string]interface{}{"$ref": "#/components/schemas/Response"},
+				},
+			},
+		},
+	}
+	return op
+}
+
+func requiredOf(schema map[string]interface{}) []string {
+	required, _ := schema["required"].([]string)
+	return required
+}
+
+// schemaOf 按tag(json或form)和binding标签生成结构体的schema
+func schemaOf(t reflect.Type, tag string) map[string]interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": schemaOf(t.Elem(), tag)}
+	case reflect.Struct:
+		properties := make(map[string]interface{})
+		var required []string
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name := strings.Split(field.Tag.Get(tag), ",")[0]
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			property := schemaOf(field.Type, tag)
+			if applyBinding(property, field.Tag.Get("binding")) {
+				required = append(required, name)
+			}
+			properties[name] = property
+		}
+		schema := map[string]interface{}{
+			"type":       "object",
+			"properties": properties,
+		}
+		if len(required) > 0 {
+			schema["required"] = required
+		}
+		return schema
+	}
+	return map[string]interface{}{"type": "string"}
+}
+
+// applyBinding 将binding标签中的oneof、min、max写入schema，返回字段是否必填
+func applyBinding(schema map[string]interface{}, binding string) (required bool) {
+	for _, rule := range strings.Split(binding, ",") {
+		key, value, _ := strings.Cut(rule, "=")
+		switch key {
+		case "required":
+			required = true
+		case "oneof":
+			var enum []interface{}
+			for _, v := range strings.Fields(value) {
+				if schema["type"] == "integer" {
+					n, _ := strconv.ParseInt(v, 10, 64)
+					enum = append(enum, n)
+				} else {
+					enum = append(enum, v)
+				}
+			}
+			schema["enum"] = enum
+		case "min", "max":
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				continue
+			}
+			switch schema["type"] {
+			case "string":
+				schema[key+"Length"] = n
+			case "array":
+				schema[key+"Items"] = n
+			default:
+				schema[map[string]string{"min": "minimum", "max": "maximum"}[key]] = n
+			}
+		}
+	}
+	return required
+}
@@ -14,6 +14,7 @@ type Comment struct {
 	Reply         int64      `db:"reply"`       // 评论回复数
 	BeCommentId   int64      `db:"beCommentId"` // 关联评论id
 	ChildComments []*Comment `db:"-"`           // 子评论列表，不存储在数据库中
+	ReplyCursor   string     `db:"-"`           // 子评论游标，为空表示没有更多回复，不存储在数据库中
 }
 
 func (c *Comment) MarshalBinary() (data []byte, err error) {
@@ -56,11 +56,23 @@ CREATE TABLE post
     star        BIGINT   DEFAULT 0 COMMENT '点赞数',
     comment     BIGINT   DEFAULT 0 COMMENT '评论数',
     content     VARCHAR(2047)     NOT NULL COMMENT '帖子内容',
+    video       VARCHAR(255) DEFAULT '' COMMENT '视频路径',
     title       VARCHAR(20)       NOT NULL COMMENT '标题',
     isScan      BOOL     DEFAULT 1 COMMENT '可见性',
     communityId BIGINT(20)        NOT NULL COMMENT '社区id'
 ) COMMENT '帖子表';
 
+CREATE TABLE post_edit_history
+(
+    historyId BIGINT(20) PRIMARY KEY COMMENT '编辑记录id',
+    postId    BIGINT(20)    NOT NULL COMMENT '帖子id',
+    userId    BIGINT(20)    NOT NULL COMMENT '用户id',
+    content   VARCHAR(2047) NOT NULL COMMENT '修改前的帖子内容',
+    isScan    BOOL     DEFAULT 1 COMMENT '修改前的可见性',
+    editTime  DATETIME DEFAULT CURRENT_TIMESTAMP COMMENT '修改时间',
+    INDEX (postId)
+) COMMENT '帖子编辑历史表';
+
 CREATE TABLE postComment
 (
     createdAt   DATETIME   DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
@@ -71,7 +83,9 @@ CREATE TABLE postComment
     content     VARCHAR(511) NOT NULL COMMENT '评论内容',
     star        BIGINT     DEFAULT 0 COMMENT '点赞数',
     reply       BIGINT     DEFAULT 0 COMMENT '回复数',
-    beCommentId BIGINT(20) DEFAULT NULL COMMENT '关联评论id'
+    beCommentId BIGINT(20) DEFAULT NULL COMMENT '关联评论id',
+    INDEX (postId, beCommentId, star, commentId),
+    INDEX (beCommentId, commentId)
 ) COMMENT '评论表';
 
 CREATE TABLE community
@@ -95,7 +109,8 @@ CREATE TABLE `group_messages`
     `sendTime`    datetime     NOT NULL COMMENT '消息发送时间',
     `content`     varchar(255) NOT NULL COMMENT '内容',
     `sdUserId`    bigint       NOT NULL COMMENT '发送方id',
-    `communityId` bigint       NOT NULL COMMENT '社区id'
+    `communityId` bigint       NOT NULL COMMENT '社区id',
+    INDEX (`communityId`, `chatId`)
 ) COMMENT ='群聊表';
 
 
@@ -105,10 +120,12 @@ CREATE TABLE `private_messages`
     `sender_id`          bigint       NOT NULL COMMENT '发送方',
     `recipient_id`       bigint       NOT NULL COMMENT '接受方',
     `content`            varchar(255) NOT NULL COMMENT '内容',
-    `state`              boolean DEFAULT FALSE COMMENT '是否已读',
+    `status`             boolean DEFAULT FALSE COMMENT '是否已读',
     `send_time`          datetime     NOT NULL COMMENT '发送时间',
+    `private_chat_id`    bigint       NOT NULL COMMENT '会话id',
     PRIMARY KEY (`private_message_id`),
-    INDEX (`recipient_id`)
+    INDEX (`recipient_id`, `status`),
+    INDEX (`private_chat_id`, `send_time`)
 ) comment '私信表';
 
 
@@ -126,41 +143,58 @@ create table `private_chat`
 create table `manager_system_notice`
 (
     system_notice_id bigint,
-    tile             varchar(30) comment '标题',
+    title            varchar(30) comment '标题',
     content          varchar(255) comment '内容',
-    type             varchar(10) comment '类型',        -- single 单用户  all 全体用户
-    state            boolean comment '状态',            -- 是否被拉取过
-    notice_user_id   bigint(20) comment '通知的用户id', -- 如果为全体用户则为0
+    type             varchar(10) comment '类型',        -- single 单用户  all 全体用户  community 社区关注者  users 指定用户
+    status           boolean comment '状态',            -- 是否被拉取过
+    recipient_id     bigint(20) comment '通知的用户id', -- 如果为全体用户则为0
+    target_id        bigint(20) default 0 comment '通知的社区id',
     manager_id       bigint(20) comment '管理员用户id',
-    publish_time     datetime comment '发布时间',
-    primary key (system_notice_id)
+    publish_time     datetime comment '发布时间',       -- 定时通知到达发布时间后才会被拉取
+    revokedAt        datetime default null comment '撤回时间',
+    insertedAt       datetime default current_timestamp comment '写入时间', -- 消息消费者写入的时间，拉取通知时以此判断是否已拉取过
+    primary key (system_notice_id),
+    index (publish_time),
+    index (insertedAt)
 ) comment '管理员系统通知表';
 
 
+create table `system_notice_recipient`
+(
+    system_notice_id bigint comment '系统通知id',
+    recipient_id     bigint comment '通知的用户id',
+    primary key (system_notice_id, recipient_id)
+) comment '指定用户的系统通知接收者表';
+
+
 create table `user_system_notice`
 (
     user_notice_id   bigint,
-    state            boolean comment '是否已读',
+    status           boolean comment '是否已读',
     system_notice_id bigint comment '系统通知id',
-    notice_user_id   bigint comment '接受通知的用户id',
+    recipient_id     bigint comment '接受通知的用户id',
     pull_time        datetime comment '拉取时间',
     primary key (user_notice_id),
-    index (system_notice_id)
+    unique index (system_notice_id, recipient_id),
+    index (recipient_id, status)
 ) comment '用户系统通知表';
 
 
 create table `like_remind`
 (
-    like_remind_id bigint,
-    like_id        bigint comment '点赞源id',-- 评论id 帖子id
-    like_type      varchar(10) comment '点赞源类型', -- "comment","feed"
-    like_content   varchar(255) comment '点赞源内容',
-    url            varchar(255) comment '点赞源链接',
-    state          boolean comment '是否已读',
-    sender_id      boolean comment '点赞人id',
-    recipient_id   boolean comment '接受通知的人的id',
-    remind_time    datetime comment '提醒时间',
-    primary key (like_remind_id)
+    id           bigint,
+    source_id    bigint comment '点赞源id',-- 评论id 帖子id
+    source_type  varchar(10) comment '点赞源类型', -- "comment","feed"
+    content      varchar(255) comment '点赞源内容',
+    url          varchar(255) comment '点赞源链接',
+    status       boolean comment '是否已读',
+    sender_id    bigint comment '点赞人id',
+    recipient_id bigint comment '接受通知的人的id',
+    remind_time  datetime comment '提醒时间',
+    deletedAt    datetime default null comment '取消点赞时间',
+    primary key (id),
+    index (recipient_id, status),
+    index (source_id)
 
 ) comment '点赞提醒表';
 
@@ -168,29 +202,141 @@ create table `like_remind`
 
 create table `mention_remind`
 (
-    mention_remind_id bigint,
-    mention_id        bigint comment '@源id',-- 评论id 帖子id
-    mention_type      varchar(10) comment '@源类型', -- "comment","feed"
-    mention_content   varchar(255) comment '@源内容',
-    url               varchar(255) comment '@源链接',
-    state             boolean comment '是否已读',
-    sender_id         boolean comment '@人id',
-    recipient_id      boolean comment '接受通知的人的id',
-    remind_time       datetime comment '提醒时间',
-    primary key (mention_remind_id)
+    id           bigint,
+    source_id    bigint comment '@源id',-- 评论id 帖子id
+    source_type  varchar(10) comment '@源类型', -- "comment","feed"
+    content      varchar(255) comment '@源内容',
+    url          varchar(255) comment '@源链接',
+    status       boolean comment '是否已读',
+    sender_id    bigint comment '@人id',
+    recipient_id bigint comment '接受通知的人的id',
+    remind_time  datetime comment '提醒时间',
+    deletedAt    datetime default null comment '撤回时间',
+    primary key (id),
+    index (recipient_id, status),
+    index (source_id)
 ) comment '@提醒表';
 
 
 create table `reply_remind`
 (
-    reply_remind_id bigint,
-    reply_id        bigint comment '回复源id',-- 评论id 帖子id
-    reply_type      varchar(10) comment '回复源类型', -- "comment","feed"
-    reply_content   varchar(255) comment '回复源内容',
-    url             varchar(255) comment '回复源链接',
-    state           boolean comment '是否已读',
-    sender_id       boolean comment '回复人id',
-    recipient_id    boolean comment '接受通知的人的id',
-    remind_time     datetime comment '提醒时间',
-    primary key (reply_remind_id)
+    id           bigint,
+    source_id    bigint comment '回复源id',-- 评论id 帖子id
+    source_type  varchar(10) comment '回复源类型', -- "comment","feed"
+    content      varchar(255) comment '回复源内容',
+    url          varchar(255) comment '回复源链接',
+    status       boolean comment '是否已读',
+    sender_id    bigint comment '回复人id',
+    recipient_id bigint comment '接受通知的人的id',
+    remind_time  datetime comment '提醒时间',
+    deletedAt    datetime default null comment '撤回时间',
+    primary key (id),
+    index (recipient_id, status),
+    index (source_id)
 ) comment '回复提醒表';
+
+
+create table `favor_message`
+(
+    messageId bigint comment '点赞、收藏消息id',
+    createdAt datetime default current_timestamp comment '写入时间',
+    primary key (messageId),
+    index (createdAt)
+) comment '已写入数据库的点赞、收藏消息，用于重新投递时去重';
+
+
+CREATE TABLE userLike
+(
+    createdAt  DATETIME DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updatedAt  DATETIME DEFAULT CURRENT_TIMESTAMP COMMENT '点赞时间',
+    deletedAt  DATETIME DEFAULT NULL COMMENT '取消点赞时间',
+    userId     BIGINT(20) NOT NULL COMMENT '用户id',
+    sourceType TINYINT    NOT NULL COMMENT '点赞源类型', -- 1 帖子  2 评论
+    sourceId   BIGINT(20) NOT NULL COMMENT '点赞的帖子或评论id',
+    PRIMARY KEY (userId, sourceType, sourceId),
+    INDEX (sourceType, sourceId)
+) COMMENT '用户点赞表';
+
+
+create table `role`
+(
+    roleCode varchar(32) comment '角色编码',
+    roleName varchar(32) not null comment '角色名',
+    scope    varchar(16) not null comment '作用范围', -- global 全局  community 只在授予的社区中有效
+    primary key (roleCode)
+) comment '角色表';
+
+create table `permission`
+(
+    permissionCode varchar(64) comment '权限编码',
+    description    varchar(64) comment '说明',
+    primary key (permissionCode)
+) comment '权限表';
+
+create table `role_permission`
+(
+    roleCode       varchar(32) comment '角色编码',
+    permissionCode varchar(64) comment '权限编码',
+    primary key (roleCode, permissionCode)
+) comment '角色权限表';
+
+create table `user_role`
+(
+    userId      bigint      not null comment '用户id',
+    roleCode    varchar(32) not null comment '角色编码',
+    communityId bigint      not null default 0 comment '社区id，全局角色为0',
+    grantedBy   bigint      not null default 0 comment '授予者id',
+    createdAt   datetime             default current_timestamp comment '授予时间',
+    primary key (userId, roleCode, communityId),
+    index (communityId, roleCode)
+) comment '用户角色表';
+
+insert into `role`(roleCode, roleName, scope)
+values ('super_admin', '超级管理员', 'global'),
+       ('admin', '管理员', 'global'),
+       ('community_leader', '社区主持', 'community'),
+       ('community_moderator', '社区管理员', 'community'),
+       ('user', '普通用户', 'global');
+
+insert into `permission`(permissionCode, description)
+values ('*', '全部权限'),
+       ('category:manage', '管理分类'),
+       ('file:upload', '上传管理文件'),
+       ('system_message:send', '发送和撤回系统通知'),
+       ('favor:reconcile', '对账点赞、收藏计数'),
+       ('role:grant', '授予或撤销全局角色和社区主持'),
+       ('community_role:grant', '授予或撤销社区管理员'),
+       ('post:delete', '删除他人的帖子'),
+       ('comment:delete', '删除他人的评论');
+
+insert into `role_permission`(roleCode, permissionCode)
+values ('super_admin', '*'),
+       ('admin', 'category:manage'),
+       ('admin', 'file:upload'),
+       ('admin', 'system_message:send'),
+       ('admin', 'favor:reconcile'),
+       ('admin', 'community_role:grant'),
+       ('admin', 'post:delete'),
+       ('admin', 'comment:delete'),
+       ('community_leader', 'community_role:grant'),
+       ('community_leader', 'post:delete'),
+       ('community_leader', 'comment:delete'),
+       ('community_moderator', 'post:delete'),
+       ('community_moderator', 'comment:delete');
+
+-- 已有社区的主持和管理员
+insert ignore into `user_role`(userId, roleCode, communityId)
+select leaderId, 'community_leader', communityId
+from community;
+insert ignore into `user_role`(userId, roleCode, communityId)
+select manageId, 'community_moderator', communityId
+from community
+where manageId <> 0;
+
+
+create table `data_migration`
+(
+    name       varchar(64) not null comment '迁移名称',
+    finishedAt datetime default current_timestamp comment '完成时间',
+    primary key (name)
+) comment '已完成的数据迁移，服务据此判断能否使用迁移后的数据';
@@ -0,0 +1,74 @@
+package models
+
+import "time"
+
+// 点赞、收藏计数的类型
+const (
+	FavorPostLike    = "post_like"
+	FavorCommentLike = "comment_like"
+	FavorPostCollect = "post_collect"
+)
+
+// 点赞记录的来源类型，与likePb的SourceType一致
+const (
+	LikeSourcePost    = 1
+	LikeSourceComment = 2
+)
+
+// LikeMessage 点赞消息，由favorconsumer写入点赞记录和点赞数
+type LikeMessage struct {
+	SourceId int64 `json:"source_id"`
+	UserId   int64 `json:"user_id"`
+	Star     int64 `json:"star"` //1 点赞  -1 取消点赞
+}
+
+// LikeRecord 用户的点赞记录
+type LikeRecord struct {
+	SourceId int64     `db:"sourceId"`
+	LikeTime time.Time `db:"updatedAt"`
+}
+
+// FavorDelta 一条点赞、收藏消息带来的计数变化
+type FavorDelta struct {
+	MessageId int64 //生产者分配的消息id，为0时不去重
+	SourceId  int64 //帖子或评论id
+	UserId    int64 //点赞或收藏的用户id，为0时只更新计数
+	Value     int64 //1或-1
+}
+
+// FavorUser 用户对帖子或评论的点赞、收藏关系
+type FavorUser struct {
+	UserId   int64
+	SourceId int64
+}
+
+// FavorBatch 合并后的计数变化
+type FavorBatch struct {
+	Counts map[int64]int64     //帖子或评论的计数变化
+	Users  map[FavorUser]int64 //用户关系的净变化，大于0为点赞或收藏，小于0为取消
+}
+
+// MergeFavorDeltas 合并计数变化，变化为0的帖子或评论不写入数据库
+func MergeFavorDeltas(deltas []*FavorDelta) *FavorBatch {
+	batch := &FavorBatch{
+		Counts: make(map[int64]int64),
+		Users:  make(map[FavorUser]int64),
+	}
+	for _, delta := range deltas {
+		batch.Counts[delta.SourceId] += delta.Value
+		if delta.UserId != 0 {
+			batch.Users[FavorUser{UserId: delta.UserId, SourceId: delta.SourceId}] += delta.Value
+		}
+	}
+	for id, count := range batch.Counts {
+		if count == 0 {
+			delete(batch.Counts, id)
+		}
+	}
+	for user, value := range batch.Users {
+		if value == 0 {
+			delete(batch.Users, user)
+		}
+	}
+	return batch
+}
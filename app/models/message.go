@@ -6,12 +6,12 @@ import (
 )
 
 type Counts struct {
-	MentionCount    uint32 ` db:"mentionCount" json:"mention_count"`
-	LikeCount       uint32 `db:"likeCount" json:"like_count"`
-	ReplyCount      uint32 `db:"replyCount" json:"reply_count"`
-	SystemCount     uint32 `db:"systemCount" json:"system_count"`
-	PrivateMsgCount uint32 `db:"privateMsgCount" json:"private_msg_count"`
-	TotalCount      uint32 `json:"total_count"`
+	MentionCount    uint32 ` db:"mentionCount" json:"mention_count" redis:"mention"`
+	LikeCount       uint32 `db:"likeCount" json:"like_count" redis:"like"`
+	ReplyCount      uint32 `db:"replyCount" json:"reply_count" redis:"reply"`
+	SystemCount     uint32 `db:"systemCount" json:"system_count" redis:"system"`
+	PrivateMsgCount uint32 `db:"privateMsgCount" json:"private_msg_count" redis:"private_msg"`
+	TotalCount      uint32 `json:"total_count" redis:"-"`
 }
 type PrivateMessage struct {
 	Id            int64     `db:"private_message_id" json:"private_msg_id"`
@@ -31,9 +31,20 @@ type PrivateChat struct {
 	LastSendTime   time.Time `db:"last_message_time" json:"last_message_time"`
 }
 
+// 系统通知的发送对象
+const (
+	NoticeSingle    = "single"    //单个用户
+	NoticeAll       = "all"       //全体用户
+	NoticeCommunity = "community" //社区的关注者
+	NoticeUsers     = "users"     //指定的用户列表
+)
+
+// SystemMessage 系统通知只保存一份，用户拉取通知时才写入user_system_notice
 type SystemMessage struct {
 	Id          int64     `db:"system_notice_id" json:"system_notice_id"`
 	RecipientId int64     `db:"recipient_id" json:"recipient_id"`
+	TargetId    int64     `db:"target_id" json:"target_id"`
+	Recipients  []int64   `db:"-" json:"recipients"`
 	ManagerId   int64     `db:"manager_id" json:"manager_id"`
 	Type        string    `db:"type" json:"type"`
 	Title       string    `db:"title" json:"title"`
@@ -67,6 +78,22 @@ type RemindMessage struct {
 	IsDeleted   bool      `json:"is_deleted"`
 }
 
+// LikeRemind 按点赞源聚合的点赞提醒，内容和链接取最新一条
+type LikeRemind struct {
+	RemindMessage
+	SenderCount int64   `db:"sender_count" json:"sender_count"`
+	SenderIds   []int64 `db:"-" json:"sender_ids"` //最近点赞的用户
+}
+
+// UserSystemNotice 用户收到的系统通知
+type UserSystemNotice struct {
+	Id          int64     `db:"user_notice_id" json:"user_notice_id"`
+	Title       string    `db:"title" json:"title"`
+	Content     string    `db:"content" json:"content"`
+	Status      bool      `db:"status" json:"status"`
+	PublishTime time.Time `db:"publish_time" json:"publish_time"`
+}
+
 func GetPrivateChat(m *PrivateMessage) *PrivateChat {
 	var user1Id, user2Id int64
 	if m.SenderId < m.RecipientId {
@@ -0,0 +1,53 @@
+package models
+
+import "time"
+
+// 角色编码，与role表一致
+const (
+	RoleSuperAdmin         = "super_admin"
+	RoleAdmin              = "admin"
+	RoleCommunityLeader    = "community_leader"
+	RoleCommunityModerator = "community_moderator"
+	RoleUser               = "user" //所有登录用户默认的角色，不需要授予
+)
+
+// 角色的作用范围
+const (
+	RoleScopeGlobal    = "global"
+	RoleScopeCommunity = "community" //只在授予的社区中有效
+)
+
+// 权限编码，与permission表一致
+const (
+	PermAll                = "*" //拥有全部权限
+	PermCategoryManage     = "category:manage"
+	PermFileUpload         = "file:upload"
+	PermSystemMessage      = "system_message:send"
+	PermFavorReconcile     = "favor:reconcile"
+	PermRoleGrant          = "role:grant"           //授予或撤销全局角色和社区主持
+	PermCommunityRoleGrant = "community_role:grant" //授予或撤销社区管理员
+	PermPostDelete         = "post:delete"          //删除他人的帖子
+	PermCommentDelete      = "comment:delete"       //删除他人的评论
+)
+
+// Role 角色
+type Role struct {
+	RoleCode string `db:"roleCode"`
+	RoleName string `db:"roleName"`
+	Scope    string `db:"scope"`
+}
+
+// UserRole 用户拥有的角色，全局角色的CommunityId为0
+type UserRole struct {
+	UserId      int64     `db:"userId"`
+	RoleCode    string    `db:"roleCode"`
+	CommunityId int64     `db:"communityId"`
+	GrantedBy   int64     `db:"grantedBy"`
+	CreatedAt   time.Time `db:"createdAt"`
+}
+
+// RolePermission 角色拥有的权限
+type RolePermission struct {
+	RoleCode       string `db:"roleCode"`
+	PermissionCode string `db:"permissionCode"`
+}
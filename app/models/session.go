@@ -0,0 +1,12 @@
+package models
+
+// Session 用户在一个设备上的登录会话，同一会话轮换出的refresh token属于同一个family
+type Session struct {
+	SessionId    string `redis:"sessionId"`
+	UserId       int64  `redis:"userId"`
+	Device       string `redis:"device"`
+	Ip           string `redis:"ip"`
+	RefreshId    string `redis:"refreshId"`    //当前有效的refresh token id，旧的refresh token被再次使用时会话被撤销
+	CreatedAt    int64  `redis:"createdAt"`    //登录时间
+	LastActiveAt int64  `redis:"lastActiveAt"` //最近一次刷新token的时间
+}
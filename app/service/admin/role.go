@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"star/app/constant/str"
+	"star/app/extra/tracing"
+	"star/app/models"
+	"star/app/storage/mysql"
+	"star/app/utils/logging"
+	"star/app/utils/rbac"
+	"star/proto/admin/adminPb"
+	"time"
+)
+
+// CheckPermission 判断用户是否拥有全部权限
+func (a *AdminSrv) CheckPermission(ctx context.Context, req *adminPb.CheckPermissionRequest, resp *adminPb.CheckPermissionResponse) error {
+	_, span := tracing.Tracer.Start(ctx, "CheckPermissionService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "AdminService.CheckPermission")
+
+	for _, permission := range req.Permissions {
+		ok, err := rbac.HasPermission(req.UserId, permission, req.CommunityId)
+		if err != nil {
+			logger.Error("check permission error",
+				zap.Error(err),
+				zap.Int64("userId", req.UserId),
+				zap.String("permission", permission))
+			logging.SetSpanError(span, err)
+			return str.ErrRoleError
+		}
+		if !ok {
+			return nil
+		}
+	}
+	resp.Allowed = true
+	return nil
+}
+
+// GrantRole 授予角色
+func (a *AdminSrv) GrantRole(ctx context.Context, req *adminPb.GrantRoleRequest, resp *adminPb.GrantRoleResponse) error {
+	_, span := tracing.Tracer.Start(ctx, "GrantRoleService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "AdminService.GrantRole")
+
+	if err := checkRoleOperation(span, logger, req.ActorId, req.RoleCode, req.CommunityId); err != nil {
+		return err
+	}
+	if err := mysql.GrantRole(&models.UserRole{
+		UserId:      req.UserId,
+		RoleCode:    req.RoleCode,
+		CommunityId: req.CommunityId,
+		GrantedBy:   req.ActorId,
+	}); err != nil {
+		logger.Error("grant role error",
+			zap.Error(err),
+			zap.Int64("userId", req.UserId),
+			zap.String("roleCode", req.RoleCode),
+			zap.Int64("communityId", req.CommunityId))
+		logging.SetSpanError(span, err)
+		return str.ErrRoleError
+	}
+	logger.Info("grant role",
+		zap.Int64("actorId", req.ActorId),
+		zap.Int64("userId", req.UserId),
+		zap.String("roleCode", req.RoleCode),
+		zap.Int64("communityId", req.CommunityId))
+	return nil
+}
+
+// RevokeRole 撤销角色
+func (a *AdminSrv) RevokeRole(ctx context.Context, req *adminPb.RevokeRoleRequest, resp *adminPb.RevokeRoleResponse) error {
+	_, span := tracing.Tracer.Start(ctx, "RevokeRoleService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "AdminService.RevokeRole")
+
+	if err := checkRoleOperation(span, logger, req.ActorId, req.RoleCode, req.CommunityId); err != nil {
+		return err
+	}
+	if err := mysql.RevokeRole(req.UserId, req.RoleCode, req.CommunityId); err != nil {
+		if errors.Is(err, str.ErrUserRoleNotExists) {
+			return err
+		}
+		logger.Error("revoke role error",
+			zap.Error(err),
+			zap.Int64("userId", req.UserId),
+			zap.String("roleCode", req.RoleCode),
+			zap.Int64("communityId", req.CommunityId))
+		logging.SetSpanError(span, err)
+		return str.ErrRoleError
+	}
+	logger.Info("revoke role",
+		zap.Int64("actorId", req.ActorId),
+		zap.Int64("userId", req.UserId),
+		zap.String("roleCode", req.RoleCode),
+		zap.Int64("communityId", req.CommunityId))
+	return nil
+}
+
+// ListUserRoles 获取用户的角色
+func (a *AdminSrv) ListUserRoles(ctx context.Context, req *adminPb.ListUserRolesRequest, resp *adminPb.ListUserRolesResponse) error {
+	_, span := tracing.Tracer.Start(ctx, "ListUserRolesService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "AdminService.ListUserRoles")
+
+	roles, err := mysql.GetUserRoles(req.UserId)
+	if err != nil {
+		logger.Error("get user roles error",
+			zap.Error(err),
+			zap.Int64("userId", req.UserId))
+		logging.SetSpanError(span, err)
+		return str.ErrRoleError
+	}
+	resp.Roles = make([]*adminPb.UserRole, 0, len(roles))
+	for _, role := range roles {
+		resp.Roles = append(resp.Roles, &adminPb.UserRole{
+			RoleCode:    role.RoleCode,
+			CommunityId: role.CommunityId,
+			GrantedBy:   role.GrantedBy,
+			CreatedAt:   role.CreatedAt.Format(time.DateTime),
+		})
+	}
+	return nil
+}
+
+// checkRoleOperation 校验角色和作用范围，并判断操作者能否授予或撤销该角色：
+// 全局角色和社区主持需要全局的role:grant权限，社区管理员需要该社区的community_role:grant权限
+func checkRoleOperation(span trace.Span, logger *zap.Logger, actorId int64, roleCode string, communityId int64) error {
+	role, err := mysql.GetRole(roleCode)
+	if err != nil {
+		if errors.Is(err, str.ErrRoleNotExists) {
+			return err
+		}
+		logger.Error("get role error",
+			zap.Error(err),
+			zap.String("roleCode", roleCode))
+		logging.SetSpanError(span, err)
+		return str.ErrRoleError
+	}
+	//普通用户角色所有登录用户都有，不需要授予
+	if role.RoleCode == models.RoleUser {
+		return str.ErrRoleNotExists
+	}
+	if (role.Scope == models.RoleScopeCommunity) != (communityId != 0) {
+		return str.ErrInvalidParam
+	}
+	if communityId != 0 {
+		if err := mysql.CheckCommunityExist(communityId); err != nil {
+			if errors.Is(err, str.ErrCommunityNotExists) {
+				return err
+			}
+			logger.Error("check community exist error",
+				zap.Error(err),
+				zap.Int64("communityId", communityId))
+			logging.SetSpanError(span, err)
+			return str.ErrRoleError
+		}
+	}
+
+	permission, scopeId := models.PermRoleGrant, int64(0)
+	if role.RoleCode == models.RoleCommunityModerator {
+		permission, scopeId = models.PermCommunityRoleGrant, communityId
+	}
+	if err := rbac.Authorize(actorId, permission, scopeId); err != nil {
+		if errors.Is(err, str.ErrPermissionDenied) {
+			logger.Warn("no permission to grant role",
+				zap.Int64("actorId", actorId),
+				zap.String("roleCode", roleCode),
+				zap.Int64("communityId", communityId))
+			return err
+		}
+		logger.Error("authorize error",
+			zap.Error(err),
+			zap.Int64("actorId", actorId))
+		logging.SetSpanError(span, err)
+		return str.ErrRoleError
+	}
+	return nil
+}
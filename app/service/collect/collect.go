@@ -15,6 +15,7 @@ import (
 	"star/app/storage/redis"
 	"star/app/utils/logging"
 	"star/app/utils/rabbitmq"
+	"star/app/utils/snowflake"
 	"star/proto/collect/collectPb"
 	"star/proto/feed/feedPb"
 	"strconv"
@@ -57,10 +58,10 @@ func (c *CollectSrv)New() {
 	channel, err = conn.Channel()
 	failOnError(err, "collect service failed to open a channel")
 
-	err = channel.ExchangeDeclare(str.FavorExchange, "topic", false, false, false, false, nil)
+	err = channel.ExchangeDeclare(str.FavorExchange, "topic", true, false, false, false, nil)
 	failOnError(err, "collect service failed to declare an exchange")
 
-	_, err = channel.QueueDeclare(str.CollectPost, false, false, false, false, nil)
+	_, err = channel.QueueDeclare(str.CollectPost, true, false, false, false, nil)
 	failOnError(err, "collect service failed to declare a collect queue")
 
 	err = channel.QueueBind(str.CollectPost, str.RoutCollectPost, str.FavorExchange, false, nil)
@@ -91,12 +92,14 @@ func produceCollect(ctx context.Context, req *collectPb.CollectActionRequest) {
 	}
 	err = channel.Publish(
 		str.FavorExchange,
-		str.RoutPost,
+		str.RoutCollectPost,
 		false,
 		false,
 		amqp091.Publishing{
-			ContentType: "text/plain",
-			Body:        msg,
+			ContentType:  "text/plain",
+			DeliveryMode: amqp091.Persistent,
+			MessageId:    strconv.FormatInt(snowflake.GetID(), 10),
+			Body:         msg,
 		})
 	if err != nil {
 		logging.Logger.Error("produce  collect message error",
@@ -253,7 +256,7 @@ func (c *CollectSrv) GetCollectCount(ctx context.Context, req *collectPb.GetColl
 	logging.SetSpanWithHostname(span)
 	logger := logging.LogServiceWithTrace(span, "CollectService.GetCollectCount")
 
-	key := fmt.Sprintf("feed:%d:collected_count", req.PostId)
+	key := redis.FavorCountKey(models.FavorPostCollect, req.PostId)
 	countStr, err := redis.Client.Get(ctx, key).Result()
 	if err != nil && !errors.Is(err, redis2.Nil) {
 		logger.Error("redis get feed collect count error",
@@ -7,6 +7,7 @@ import (
 	"github.com/go-redis/redis_rate/v10"
 	"go-micro.dev/v4"
 	"go.uber.org/zap"
+	"math"
 	"star/app/constant/str"
 	"star/app/extra/tracing"
 	"star/app/models"
@@ -14,9 +15,14 @@ import (
 	"star/app/storage/mysql"
 	"star/app/storage/redis"
 	"star/app/utils/logging"
+	"star/app/utils/mention"
+	"star/app/utils/rbac"
 	"star/proto/comment/commentPb"
 	"star/proto/feed/feedPb"
+	"star/proto/message/messagePb"
+	"star/proto/user/userPb"
 	"strconv"
+	"strings"
 )
 
 type CommentService struct {
@@ -25,14 +31,35 @@ type CommentService struct {
 
 const redisCommentQPS = 3
 
+const (
+	sortByHot  = iota //按点赞数排序
+	sortByTime        //按时间排序
+)
+
+const (
+	defaultCommentPageSize = 20
+	maxCommentPageSize     = 50
+	defaultReplySize       = 3
+	maxReplySize           = 10
+	maxReplyDepth          = 5 //预加载回复的最大层数，更深的回复通过GetReplies获取
+)
+
 var (
-	commentSrvIns *CommentService
-	postService   feedPb.FeedService
+	commentSrvIns  *CommentService
+	postService    feedPb.FeedService
+	userService    userPb.UserService
+	messageService messagePb.MessageService
 )
 
 func (s *CommentService) New() {
 	postMicroService := micro.NewService(micro.Name(str.FeedServiceClient))
 	postService = feedPb.NewFeedService(str.FeedService, postMicroService.Client())
+
+	userMicroService := micro.NewService(micro.Name(str.UserServiceClient))
+	userService = userPb.NewUserService(str.UserService, userMicroService.Client())
+
+	messageMicroService := micro.NewService(micro.Name(str.MessageServiceClient))
+	messageService = messagePb.NewMessageService(str.MessageService, messageMicroService.Client())
 }
 
 func commentLimitKey(userId int64) string {
@@ -82,13 +109,64 @@ func (s *CommentService) PostComment(ctx context.Context, req *commentPb.PostCom
 	}
 	key := fmt.Sprintf("CountComment:%d", req.PostId)
 	cached.Delete(ctx, key)
+	go mention.Remind(context.Background(), userService, messageService, comment.UserId, "comment", comment.CommentId, comment.Content, "", logger)
+	go remindReply(comment, false, logger)
 	rsp.Content = comment.Content
 
 	return nil
 }
 
-// GetComments 获取一个帖子的评论
-// 根据页面获取，第几页，每一页多少个评论
+// remindReply 回复评论时提醒父评论作者，顶级评论提醒帖子作者，isDeleted为true时撤回提醒
+func remindReply(comment *models.Comment, isDeleted bool, logger *zap.Logger) {
+	ctx := context.Background()
+	var recipientId int64
+	if comment.BeCommentId != 0 {
+		parent, err := mysql.GetCommentInfo(comment.BeCommentId)
+		if err != nil {
+			logger.Error("get parent comment info error",
+				zap.Error(err),
+				zap.Int64("beCommentId", comment.BeCommentId))
+			return
+		}
+		recipientId = parent.UserId
+	} else {
+		post, err := redis.GetPostInfo(ctx, comment.PostId)
+		if err != nil {
+			logger.Error("get post info error",
+				zap.Error(err),
+				zap.Int64("postId", comment.PostId))
+			return
+		}
+		recipientId = post.UserId
+	}
+	sendReplyRemind(ctx, comment, recipientId, isDeleted, logger)
+}
+
+// sendReplyRemind 向recipientId发送评论的回复提醒，回复自己不提醒
+func sendReplyRemind(ctx context.Context, comment *models.Comment, recipientId int64, isDeleted bool, logger *zap.Logger) {
+	if recipientId == comment.UserId {
+		return
+	}
+	_, err := messageService.SendRemindMessage(ctx, &messagePb.SendRemindMessageRequest{
+		SenderId:    comment.UserId,
+		RecipientId: recipientId,
+		SourceId:    comment.CommentId,
+		SourceType:  "comment",
+		RemindType:  "reply",
+		Content:     mention.Excerpt(comment.Content, mention.ExcerptLength),
+		IsDeleted:   isDeleted,
+	})
+	if err != nil {
+		logger.Error("send reply remind message error",
+			zap.Error(err),
+			zap.Int64("commentId", comment.CommentId),
+			zap.Int64("recipientId", recipientId),
+			zap.Bool("isDeleted", isDeleted))
+	}
+}
+
+// GetComments 分页获取一个帖子的顶级评论
+// 每条评论只预加载前几条回复，剩余回复通过GetReplies按replyCursor继续获取
 func (s *CommentService) GetComments(ctx context.Context, req *commentPb.GetCommentsRequest, rsp *commentPb.GetCommentsResponse) error {
 	ctx, span := tracing.Tracer.Start(ctx, "GetCommentsService")
 	defer span.End()
@@ -113,56 +191,179 @@ func (s *CommentService) GetComments(ctx context.Context, req *commentPb.GetComm
 		logging.SetSpanError(span, err)
 		return str.ErrPostNotExists
 	}
-	// 按照点赞数排序，获取所有评论
-	comments, err := mysql.GetCommentsStar(req.PostId)
+
+	pageSize := clampSize(req.PageSize, defaultCommentPageSize, maxCommentPageSize)
+	var comments []*models.Comment
+	switch req.SortType {
+	case sortByHot:
+		var lastStar, lastCommentId int64
+		lastStar, lastCommentId, err = parseHotCursor(req.Cursor)
+		if err != nil {
+			logger.Error("invalid comment cursor",
+				zap.Error(err),
+				zap.String("cursor", req.Cursor))
+			return str.ErrInvalidParam
+		}
+		// 多查一条用于判断是否还有下一页
+		comments, err = mysql.GetCommentsStar(req.PostId, lastStar, lastCommentId, pageSize+1)
+	case sortByTime:
+		var lastCommentId int64
+		lastCommentId, err = parseIdCursor(req.Cursor, math.MaxInt64)
+		if err != nil {
+			logger.Error("invalid comment cursor",
+				zap.Error(err),
+				zap.String("cursor", req.Cursor))
+			return str.ErrInvalidParam
+		}
+		comments, err = mysql.GetCommentsTime(req.PostId, lastCommentId, pageSize+1)
+	default:
+		return str.ErrInvalidParam
+	}
 	if err != nil {
-		logger.Error("mysql get comment star error",
-			zap.Error(err))
+		logger.Error("mysql get comments error",
+			zap.Error(err),
+			zap.Int64("post_id", req.PostId),
+			zap.Int32("sortType", req.SortType))
 		logging.SetSpanError(span, err)
 		return str.ErrCommentError
 	}
+	if len(comments) > pageSize {
+		comments = comments[:pageSize]
+		last := comments[pageSize-1]
+		if req.SortType == sortByHot {
+			rsp.NextCursor = fmt.Sprintf("%d_%d", last.Star, last.CommentId)
+		} else {
+			rsp.NextCursor = strconv.FormatInt(last.CommentId, 10)
+		}
+	}
 
-	// 构建评论树
-	commentTree, err := buildCommentTree(comments)
-	if err != nil {
-		logger.Error("create comment tree error",
-			zap.Error(err))
+	// 逐层预加载每条评论的前几条回复
+	replySize := clampSize(req.ReplySize, defaultReplySize, maxReplySize)
+	if err := preloadReplies(comments, replySize); err != nil {
+		logger.Error("mysql get replies error",
+			zap.Error(err),
+			zap.Int64("post_id", req.PostId))
 		logging.SetSpanError(span, err)
 		return str.ErrCommentError
 	}
 
-	// 将评论树转换为 protobuf 格式
-	rsp.Comments = convertCommentsToPB(commentTree)
+	// 将评论转换为 protobuf 格式
+	rsp.Comments = convertCommentsToPB(comments)
 
 	return nil
 }
 
-// buildCommentTree 构建评论树
-func buildCommentTree(comments []*models.Comment) ([]*models.Comment, error) {
-	// 创建一个map，用于存储所有评论
-	commentsMap := make(map[int64]*models.Comment)
-	for _, comment := range comments {
-		commentsMap[comment.CommentId] = comment
-	}
+// GetReplies 按时间正序分页获取一条评论的直接回复
+func (s *CommentService) GetReplies(ctx context.Context, req *commentPb.GetRepliesRequest, rsp *commentPb.GetRepliesResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "GetRepliesService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "CommentService.GetReplies")
 
-	// 创建一个切片，用于存储顶级评论
-	var rootComments []*models.Comment
+	lastCommentId, err := parseIdCursor(req.Cursor, 0)
+	if err != nil {
+		logger.Error("invalid reply cursor",
+			zap.Error(err),
+			zap.String("cursor", req.Cursor))
+		return str.ErrInvalidParam
+	}
+	if err := mysql.CheckComment(req.CommentId); err != nil {
+		if errors.Is(err, str.ErrCommentNotExists) {
+			return err
+		}
+		logger.Error("check comment error",
+			zap.Error(err),
+			zap.Int64("commentId", req.CommentId))
+		logging.SetSpanError(span, err)
+		return str.ErrCommentError
+	}
+	pageSize := clampSize(req.PageSize, defaultCommentPageSize, maxCommentPageSize)
+	replies, err := mysql.GetReplies(req.CommentId, lastCommentId, pageSize+1)
+	if err != nil {
+		logger.Error("mysql get replies error",
+			zap.Error(err),
+			zap.Int64("commentId", req.CommentId))
+		logging.SetSpanError(span, err)
+		return str.ErrCommentError
+	}
+	if len(replies) > pageSize {
+		replies = replies[:pageSize]
+		rsp.NextCursor = strconv.FormatInt(replies[pageSize-1].CommentId, 10)
+	}
+	rsp.Replies = convertCommentsToPB(replies)
+	return nil
+}
 
-	// 遍历所有评论，构建树结构
-	for _, comment := range comments {
-		if comment.BeCommentId == 0 {
-			// 如果是顶级评论，直接添加到顶级评论切片中
-			rootComments = append(rootComments, comment)
-		} else if parent, ok := commentsMap[comment.BeCommentId]; ok {
-			// 如果有父评论，将当前评论添加到父评论的子评论列表
-			if parent.ChildComments == nil {
-				parent.ChildComments = []*models.Comment{}
+// preloadReplies 逐层预加载回复，每层一次查询，每条评论最多加载replySize条，
+// 未加载完的评论设置replyCursor，超过maxReplyDepth层的回复从头获取
+func preloadReplies(comments []*models.Comment, replySize int) error {
+	parents := comments
+	for depth := 0; depth < maxReplyDepth && len(parents) > 0; depth++ {
+		ids := make([]int64, 0, len(parents))
+		for _, parent := range parents {
+			if parent.Reply > 0 {
+				ids = append(ids, parent.CommentId)
+			}
+		}
+		// 多查一条用于判断是否还有更多回复
+		grouped, err := mysql.GetRepliesBatch(ids, replySize+1)
+		if err != nil {
+			return err
+		}
+		var children []*models.Comment
+		for _, parent := range parents {
+			replies := grouped[parent.CommentId]
+			if len(replies) > replySize {
+				replies = replies[:replySize]
+				parent.ReplyCursor = strconv.FormatInt(replies[replySize-1].CommentId, 10)
 			}
-			parent.ChildComments = append(parent.ChildComments, comment)
+			parent.ChildComments = replies
+			children = append(children, replies...)
 		}
+		parents = children
 	}
+	for _, parent := range parents {
+		if parent.Reply > 0 && parent.ChildComments == nil {
+			parent.ReplyCursor = "0"
+		}
+	}
+	return nil
+}
 
-	return rootComments, nil
+// clampSize 未传时使用默认值，超过上限时取上限
+func clampSize(size int32, defaultSize int, maxSize int) int {
+	if size <= 0 {
+		return defaultSize
+	}
+	return min(int(size), maxSize)
+}
+
+// parseHotCursor 解析按热度排序的游标，格式为 点赞数_评论id
+func parseHotCursor(cursor string) (int64, int64, error) {
+	if cursor == "" {
+		return math.MaxInt64, math.MaxInt64, nil
+	}
+	starStr, commentIdStr, ok := strings.Cut(cursor, "_")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid cursor: %s", cursor)
+	}
+	star, err := strconv.ParseInt(starStr, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	commentId, err := strconv.ParseInt(commentIdStr, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return star, commentId, nil
+}
+
+// parseIdCursor 解析评论id游标，为空时返回defaultId
+func parseIdCursor(cursor string, defaultId int64) (int64, error) {
+	if cursor == "" {
+		return defaultId, nil
+	}
+	return strconv.ParseInt(cursor, 10, 64)
 }
 
 // convertCommentsToPB 将评论树转换为protobuf格式
@@ -179,6 +380,7 @@ func convertCommentsToPB(comments []*models.Comment) []*commentPb.Comment {
 			BeCommentId: comment.BeCommentId,
 			Reply:       comment.Reply,
 			CreatedAt:   comment.CreatedAt,
+			ReplyCursor: comment.ReplyCursor,
 		}
 
 		if comment.ChildComments != nil {
@@ -198,13 +400,24 @@ func (s *CommentService) DeleteComment(ctx context.Context, req *commentPb.Delet
 	logging.SetSpanWithHostname(span)
 	logger := logging.LogServiceWithTrace(span, "CommentService.DeleteComment")
 
-	// 检查评论是否存在
-	if err := mysql.CheckComment(req.CommentId); err != nil {
+	// 检查评论是否存在，并取出评论信息用于撤回回复提醒
+	comment, err := mysql.GetCommentInfo(req.CommentId)
+	if err != nil {
 		logger.Error("check comment error",
 			zap.Error(err))
 		logging.SetSpanError(span, err)
 		return str.ErrCommentError
 	}
+	if comment.UserId != req.ActorId {
+		//不是作者时，需要在帖子所在社区有删除评论的权限
+		if err := authorizeDeleteComment(comment, req.ActorId); err != nil {
+			logger.Warn("user can not delete the comment",
+				zap.Error(err),
+				zap.Int64("commentId", req.CommentId),
+				zap.Int64("actorId", req.ActorId))
+			return err
+		}
+	}
 
 	// 清除对应评论的Redis缓存
 	if err := redis.Client.Del(ctx, fmt.Sprintf("comment:star:%d", req.CommentId)).Err(); err != nil {
@@ -215,13 +428,50 @@ func (s *CommentService) DeleteComment(ctx context.Context, req *commentPb.Delet
 	}
 
 	//删除评论
-	if err := mysql.DeleteComment(req.CommentId); err != nil {
+	replies, err := mysql.DeleteComment(req.CommentId)
+	if err != nil {
 		logger.Error("delete comment error",
 			zap.Error(err),
 			zap.Int64("commentId", req.CommentId))
 		logging.SetSpanError(span, err)
 		return str.ErrCommentError
 	}
+	go retractReminds(comment, replies, logger)
+	return nil
+}
+
+// retractReminds 撤回被删除的评论及其所有回复的回复提醒和提及提醒
+func retractReminds(comment *models.Comment, replies []*models.Comment, logger *zap.Logger) {
+	ctx := context.Background()
+	remindReply(comment, true, logger)
+	mention.Retract(ctx, userService, messageService, comment.UserId, "comment", comment.CommentId, comment.Content, logger)
+	// 父评论已一并删除，无法再查询，回复提醒的接收者从本次删除的评论中取
+	authors := make(map[int64]int64, len(replies)+1)
+	authors[comment.CommentId] = comment.UserId
+	for _, reply := range replies {
+		authors[reply.CommentId] = reply.UserId
+	}
+	for _, reply := range replies {
+		sendReplyRemind(ctx, reply, authors[reply.BeCommentId], true, logger)
+		mention.Retract(ctx, userService, messageService, reply.UserId, "comment", reply.CommentId, reply.Content, logger)
+	}
+}
+
+// authorizeDeleteComment 判断操作者能否删除他人的评论
+func authorizeDeleteComment(comment *models.Comment, actorId int64) error {
+	post, err := mysql.GetPost(comment.PostId)
+	if err != nil {
+		if errors.Is(err, str.ErrPostNotExists) {
+			return str.ErrNotCommentAuthor
+		}
+		return str.ErrCommentError
+	}
+	if err := rbac.Authorize(actorId, models.PermCommentDelete, post.CommunityId); err != nil {
+		if errors.Is(err, str.ErrPermissionDenied) {
+			return str.ErrNotCommentAuthor
+		}
+		return str.ErrCommentError
+	}
 	return nil
 }
 
@@ -0,0 +1,186 @@
+// Package aggregator 合并点赞、收藏消息中的计数变化，批量写入数据库
+//
+// 消息在所在批次写入数据库之后才被确认，进程崩溃或重启时未确认的消息由RabbitMQ重新投递，缓冲中的计数变化不会丢失；
+// 写入数据库时在同一事务中记录消息id，写入后、确认前崩溃导致重新投递的消息不会被重复计数
+package aggregator
+
+import (
+	"context"
+	"errors"
+	"github.com/rabbitmq/amqp091-go"
+	"go.uber.org/zap"
+	"star/app/extra/tracing"
+	"star/app/models"
+	"star/app/utils/logging"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var errInvalidDelta = errors.New("invalid favor delta")
+
+// Decoder 将消息体解析为计数变化
+type Decoder func(body []byte) (*models.FavorDelta, error)
+
+// Applier 在一个事务中写入一批计数变化，已写入过的消息被跳过，返回实际写入的消息数
+type Applier func(deltas []*models.FavorDelta) (int, error)
+
+// Stats 队列的写入指标
+type Stats struct {
+	Pending     int     `json:"pending"`       //等待写入的消息数
+	LagSeconds  float64 `json:"lag_seconds"`   //等待写入的消息中最早一条已等待的时间
+	LastFlushAt string  `json:"last_flush_at"` //最近一次成功写入的时间
+	LastFlushMs int64   `json:"last_flush_ms"` //最近一次写入的耗时
+	Flushed     int64   `json:"flushed"`       //已写入的消息数
+	Duplicated  int64   `json:"duplicated"`    //重复投递被跳过的消息数
+	Invalid     int64   `json:"invalid"`       //无法解析被丢弃的消息数
+	FlushErrors int64   `json:"flush_errors"`  //写入失败的次数
+}
+
+type Aggregator struct {
+	queue     string
+	decode    Decoder
+	apply     Applier
+	interval  time.Duration
+	batchSize int
+
+	mu     sync.Mutex
+	deltas []*models.FavorDelta
+	last   *amqp091.Delivery //批次中最后一条消息，写入后连同之前的消息一起确认
+	count  int               //批次中的消息数，包括无法解析的消息
+	oldest time.Time         //批次中最早一条消息的到达时间
+	stats  Stats
+}
+
+// New 创建队列的聚合器，批次中的消息数达到batchSize或距上次写入超过interval时写入数据库
+// 消费者的prefetch应不小于batchSize，否则批次在写入前无法填满
+func New(queue string, decode Decoder, apply Applier, interval time.Duration, batchSize int) *Aggregator {
+	return &Aggregator{
+		queue:     queue,
+		decode:    decode,
+		apply:     apply,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+// Run 处理队列中的消息直到delivery被关闭，关闭时写入剩余的消息
+func (a *Aggregator) Run(delivery <-chan amqp091.Delivery) {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	for {
+		in := delivery
+		// 批次已满但写入失败时暂停接收，等待下次写入
+		if a.pending() >= a.batchSize {
+			in = nil
+		}
+		select {
+		case msg, ok := <-in:
+			if !ok {
+				a.flush()
+				return
+			}
+			a.add(msg)
+			if a.pending() >= a.batchSize {
+				a.flush()
+			}
+		case <-ticker.C:
+			a.flush()
+		}
+	}
+}
+
+// add 将消息加入当前批次，无法解析的消息随批次一起确认后丢弃
+func (a *Aggregator) add(msg amqp091.Delivery) {
+	delta, err := a.decode(msg.Body)
+	if err == nil && (delta.SourceId <= 0 || (delta.Value != 1 && delta.Value != -1)) {
+		err = errInvalidDelta
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.count == 0 {
+		a.oldest = time.Now()
+	}
+	a.count++
+	a.last = &msg
+	if err != nil {
+		a.stats.Invalid++
+		zap.L().Error("decode favor message error",
+			zap.Error(err),
+			zap.String("queue", a.queue),
+			zap.ByteString("message body", msg.Body))
+		return
+	}
+	delta.MessageId, _ = strconv.ParseInt(msg.MessageId, 10, 64)
+	a.deltas = append(a.deltas, delta)
+}
+
+func (a *Aggregator) pending() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.count
+}
+
+// flush 写入当前批次并确认批次中的消息，写入失败时保留批次等待下次写入
+func (a *Aggregator) flush() {
+	a.mu.Lock()
+	count, deltas, last, oldest := a.count, a.deltas, a.last, a.oldest
+	a.mu.Unlock()
+	if count == 0 {
+		return
+	}
+
+	_, span := tracing.Tracer.Start(context.Background(), "FavorFlush")
+	defer span.End()
+	logger := logging.LogServiceWithTrace(span, "FavorConsumer.Flush")
+
+	start := time.Now()
+	applied := 0
+	if len(deltas) > 0 {
+		var err error
+		if applied, err = a.apply(deltas); err != nil {
+			a.mu.Lock()
+			a.stats.FlushErrors++
+			a.mu.Unlock()
+			logger.Error("flush favor deltas error",
+				zap.Error(err),
+				zap.String("queue", a.queue),
+				zap.Int("pending", count),
+				zap.Duration("lag", time.Since(oldest)))
+			logging.SetSpanError(span, err)
+			return
+		}
+	}
+	// 已写入数据库，确认失败时消息会被重新投递，重新投递的消息写入时会被跳过
+	if err := last.Ack(true); err != nil {
+		logger.Error("ack favor messages error",
+			zap.Error(err),
+			zap.String("queue", a.queue))
+		logging.SetSpanError(span, err)
+	}
+
+	a.mu.Lock()
+	a.count, a.deltas, a.last = 0, nil, nil
+	a.stats.Flushed += int64(applied)
+	a.stats.Duplicated += int64(len(deltas) - applied)
+	a.stats.LastFlushAt = time.Now().Format(time.RFC3339)
+	a.stats.LastFlushMs = time.Since(start).Milliseconds()
+	a.mu.Unlock()
+	logger.Debug("favor deltas flushed",
+		zap.String("queue", a.queue),
+		zap.Int("messages", count),
+		zap.Int("applied", applied),
+		zap.Duration("lag", time.Since(oldest)))
+}
+
+// Stats 获取队列当前的写入指标
+func (a *Aggregator) Stats() Stats {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	stats := a.stats
+	stats.Pending = a.count
+	if a.count > 0 {
+		stats.LagSeconds = time.Since(a.oldest).Seconds()
+	}
+	return stats
+}
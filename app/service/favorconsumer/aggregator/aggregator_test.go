@@ -0,0 +1,97 @@
+package aggregator
+
+import (
+	"errors"
+	"github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel"
+	"star/app/extra/tracing"
+	"star/app/models"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// memStore 内存中的计数和已写入的消息id，与mysql.applyFavor的事务语义一致
+type memStore struct {
+	fail    bool
+	applied map[int64]bool
+	count   int64
+}
+
+func (s *memStore) apply(deltas []*models.FavorDelta) (int, error) {
+	if s.fail {
+		return 0, errors.New("database unavailable")
+	}
+	n := 0
+	for _, delta := range deltas {
+		if s.applied[delta.MessageId] {
+			continue
+		}
+		s.applied[delta.MessageId] = true
+		s.count += delta.Value
+		n++
+	}
+	return n, nil
+}
+
+// memBroker 记录确认到的消息，ackErr模拟确认前连接断开
+type memBroker struct {
+	acked  uint64
+	ackErr error
+}
+
+func (b *memBroker) Ack(tag uint64, multiple bool) error {
+	if b.ackErr != nil {
+		return b.ackErr
+	}
+	b.acked = tag
+	return nil
+}
+
+func (b *memBroker) Nack(tag uint64, multiple bool, requeue bool) error { return nil }
+func (b *memBroker) Reject(tag uint64, requeue bool) error              { return nil }
+
+// run 投递id为1到n的点赞消息后关闭通道，聚合器在通道关闭时写入剩余的消息
+func (b *memBroker) run(store *memStore, n int) {
+	if tracing.Tracer == nil {
+		tracing.Tracer = otel.Tracer("test")
+	}
+	decode := func(body []byte) (*models.FavorDelta, error) {
+		return &models.FavorDelta{SourceId: 10, Value: 1}, nil
+	}
+	delivery := make(chan amqp091.Delivery, n)
+	for i := 1; i <= n; i++ {
+		delivery <- amqp091.Delivery{Acknowledger: b, DeliveryTag: uint64(i), MessageId: strconv.Itoa(i)}
+	}
+	close(delivery)
+	New("like_post", decode, store.apply, time.Hour, 100).Run(delivery)
+}
+
+// TestAggregatorAckAfterCommit 写入失败时不确认消息，重新投递后完整写入再确认
+func TestAggregatorAckAfterCommit(t *testing.T) {
+	store := &memStore{fail: true, applied: make(map[int64]bool)}
+	broker := new(memBroker)
+	broker.run(store, 3)
+	if broker.acked != 0 {
+		t.Fatal("messages should not be acked before flush commits")
+	}
+
+	store.fail = false
+	broker.run(store, 3)
+	if store.count != 3 || broker.acked != 3 {
+		t.Fatalf("unexpected state: count=%d acked=%d", store.count, broker.acked)
+	}
+}
+
+// TestAggregatorDedupe 写入后、确认前崩溃，重新投递的消息不会被重复计数
+func TestAggregatorDedupe(t *testing.T) {
+	store := &memStore{applied: make(map[int64]bool)}
+	broker := &memBroker{ackErr: errors.New("channel closed")}
+	broker.run(store, 2)
+
+	broker.ackErr = nil
+	broker.run(store, 3)
+	if store.count != 3 || broker.acked != 3 {
+		t.Fatalf("unexpected state: count=%d acked=%d", store.count, broker.acked)
+	}
+}
@@ -1,36 +1,23 @@
-package favorconsumer
+package main
 
 import (
-	"encoding/json"
+	"context"
+	"expvar"
+	"fmt"
 	"github.com/rabbitmq/amqp091-go"
 	"go.uber.org/zap"
-	str2 "star/app/constant/str"
-	models2 "star/app/models"
+	"net/http"
+	"star/app/constant/settings"
+	"star/app/constant/str"
+	"star/app/extra/tracing"
+	"star/app/service/favorconsumer/aggregator"
 	"star/app/storage/mysql"
 	"star/app/utils/logging"
 	"star/app/utils/rabbitmq"
 	"sync"
-	"time"
 )
 
-type collectMsg struct {
-	count   int64
-	userIds map[int64]struct{}
-}
-
 var conn *amqp091.Connection
-var channel *amqp091.Channel
-
-var likePostBuffer map[int64]int
-
-var likeCommentBuffer map[int64]int
-
-var collectPostBuffer map[int64]*collectMsg
-var deleteCollect map[int64][]int64
-
-var muLikePost sync.RWMutex
-var muLikeComment sync.RWMutex
-var muCollect sync.RWMutex
 
 func failOnError(err error, msg string) {
 	if err != nil {
@@ -38,152 +25,108 @@ func failOnError(err error, msg string) {
 	}
 }
 
-func CloseMQ() {
+func closeMQ() {
 	if err := conn.Close(); err != nil {
 		logging.Logger.Error("close rabbitmq conn error", zap.Error(err))
 		panic(err)
 	}
-	if err := channel.Close(); err != nil {
-		logging.Logger.Error("close rabbitmq channel error", zap.Error(err))
-		panic(err)
-	}
 }
-func main() {
-	var err error
-	conn, err = amqp091.Dial(rabbitmq.ReturnRabbitmqUrl())
-	failOnError(err, "Failed to connect to RabbitMQ")
-
-	channel, err = conn.Channel()
-	failOnError(err, "Failed to open a channel")
-	defer CloseMQ()
 
-	go likePostConsumer()
-	go likeCommentConsumer()
-	go asyncUpdatePostLike()
-	go asyncUpdateCommentLike()
-	go collectPostConsumer()
-	go asyncUpdatePostCollect()
+// favorQueue 点赞、收藏队列及其写入方式
+type favorQueue struct {
+	routingKey string
+	decode     aggregator.Decoder
+	apply      aggregator.Applier
 }
 
-func likePostConsumer() {
-	delivery, err := channel.Consume(str2.LikePost, str2.Empty,
-		false, false, false, false, nil)
-	failOnError(err, "Failed to register a consumer")
-
-	for msg := range delivery {
-		var message *models2.Post
-		if err := json.Unmarshal(msg.Body, &message); err != nil {
-			logging.Logger.Error("unmarshal json fail")
-			continue
-		}
-		muLikePost.Lock()
-		likePostBuffer[message.PostId] += message.Star
-		muLikePost.Unlock()
-	}
+var favorQueues = map[string]favorQueue{
+	str.LikePost:    {str.RoutPost, decodePostLike, mysql.ApplyPostLike},
+	str.LikeComment: {str.RoutComment, decodeCommentLike, mysql.ApplyCommentLike},
+	str.CollectPost: {str.RoutCollectPost, decodeCollect, mysql.ApplyCollect},
 }
-func likeCommentConsumer() {
-	delivery, err := channel.Consume(str2.LikeComment, str2.Empty,
-		false, false, false, false, nil)
-	failOnError(err, "Failed to register a consumer")
 
-	for msg := range delivery {
-		var comment *models2.Comment
-		if err := json.Unmarshal(msg.Body, &comment); err != nil {
-			logging.Logger.Error("unmarshal json fail")
-			continue
+func main() {
+	tp, err := tracing.SetTraceProvider(str.FavorConsumer)
+	if err != nil {
+		logging.Logger.Error("set tracer error",
+			zap.Error(err))
+		return
+	}
+	defer func() {
+		if err := tp.Shutdown(context.Background()); err != nil {
+			logging.Logger.Error("set tracer error",
+				zap.Error(err))
+			return
 		}
-		muLikeComment.Lock()
-		likeCommentBuffer[comment.PostId] += comment.Star
-		muLikeComment.Unlock()
+	}()
+	//连接消息队列
+	conn, err = amqp091.Dial(rabbitmq.ReturnRabbitmqUrl())
+	failOnError(err, "Failed to connect to RabbitMQ")
+	defer closeMQ()
+
+	channel, err := conn.Channel()
+	failOnError(err, "Failed to open a channel")
+	//交换机、队列和消息都持久化，broker重启时未写入数据库的计数变化不会丢失；
+	//持久化属性不能修改，从非持久化升级时需要先删除旧的交换机和队列
+	err = channel.ExchangeDeclare(str.FavorExchange, "topic", true, false, false, false, nil)
+	failOnError(err, "Failed to declare an exchange")
+	for queue, q := range favorQueues {
+		_, err = channel.QueueDeclare(queue, true, false, false, false, nil)
+		failOnError(err, fmt.Sprintf("Failed to declare a %s queue", queue))
+		err = channel.QueueBind(queue, q.routingKey, str.FavorExchange, false, nil)
+		failOnError(err, fmt.Sprintf("Failed to bind %s queue", queue))
+	}
+	if err := channel.Close(); err != nil {
+		logging.Logger.Error("close rabbitmq channel error", zap.Error(err))
 	}
-}
 
-func collectPostConsumer() {
-	delivery, err := channel.Consume(str2.CollectPost, str2.Empty,
-		false, false, false, false, nil)
-	failOnError(err, "Failed to register a consumer")
+	favorConf := settings.Conf.FavorConfig
+	aggregators := make(map[string]*aggregator.Aggregator, len(favorQueues))
+	var wg sync.WaitGroup
+	for queue, q := range favorQueues {
+		agg := aggregator.New(queue, q.decode, q.apply, favorConf.GetFlushInterval(), favorConf.GetBatchSize())
+		aggregators[queue] = agg
+		wg.Add(1)
+		go func(queue string) {
+			defer wg.Done()
+			consume(agg, queue, favorConf.GetBatchSize())
+		}(queue)
+	}
+	go purgeFavorMessage()
 
-	for msg := range delivery {
-		var post *models2.Collect
-		if err := json.Unmarshal(msg.Body, &post); err != nil {
-			logging.Logger.Error("unmarshal json fail")
-			continue
+	//写入指标通过/debug/vars暴露
+	expvar.Publish("favor", expvar.Func(func() interface{} {
+		stats := make(map[string]aggregator.Stats, len(aggregators))
+		for queue, agg := range aggregators {
+			stats[queue] = agg.Stats()
 		}
-		muCollect.Lock()
-		collectBuffer := collectPostBuffer[post.PostId]
-		collectBuffer.count += post.Collection
-		if post.Collection > 0 {
-			collectBuffer.userIds[post.UserId] = struct{}{}
-		} else {
-			if _, exists := collectBuffer.userIds[post.UserId]; exists {
-				delete(collectBuffer.userIds, post.UserId)
-			} else {
-				deleteCollect[post.PostId] = append(deleteCollect[post.PostId], post.UserId)
-			}
+		return stats
+	}))
+	go func() {
+		if err := http.ListenAndServe(favorConf.GetMetricsAddr(), nil); err != nil {
+			logging.Logger.Error("favor consumer metrics server error", zap.Error(err))
 		}
-		muCollect.Unlock()
-	}
+	}()
+	wg.Wait()
 }
-func asyncUpdatePostLike() {
-	ticker := time.NewTicker(10 * time.Second)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ticker.C:
-			muLikePost.Lock()
-			for postId, count := range likePostBuffer {
-				if err := mysql.UpdatePostLike(postId, count); err != nil {
-					logging.Logger.Error("async feed update like fail", zap.Error(err), zap.Int64("post_id", postId), zap.Int("count", count))
-				}
-				delete(likePostBuffer, postId)
-			}
-			muLikePost.Unlock()
-		}
+
+// consume 每个队列使用单独的通道，批量确认时只会确认本队列的消息
+func consume(agg *aggregator.Aggregator, queue string, prefetch int) {
+	channel, err := conn.Channel()
+	if err != nil {
+		logging.Logger.Error("Failed to open a channel", zap.Error(err), zap.String("queue", queue))
+		return
 	}
-}
-func asyncUpdateCommentLike() {
-	ticker := time.NewTicker(10 * time.Second)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ticker.C:
-			muLikeComment.Lock()
-			for commentId, count := range likeCommentBuffer {
-				if err := mysql.UpdateCommentLike(commentId, count); err != nil {
-					logging.Logger.Error("async comment update like fail", zap.Error(err), zap.Int64("comment_id", commentId), zap.Int("count", count))
-				}
-				delete(likeCommentBuffer, commentId)
-			}
-			muLikeComment.Unlock()
-		}
+	defer channel.Close()
+	if err := channel.Qos(prefetch, 0, false); err != nil {
+		logging.Logger.Error("Failed to set qos", zap.Error(err), zap.String("queue", queue))
+		return
 	}
-}
-
-func asyncUpdatePostCollect() {
-	ticker := time.NewTicker(10 * time.Second)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ticker.C:
-			muCollect.Lock()
-			for postId, userCollect := range collectPostBuffer {
-				if err := mysql.AddCollect(postId, userCollect); err != nil {
-					logging.Logger.Error("async feed add collectMsg fail",
-						zap.Error(err),
-						zap.Int64("post_id", postId),
-						zap.Int("count", userCollect.count))
-				}
-				delete(collectPostBuffer, postId)
-			}
-			for postId, userIds := range deleteCollect {
-				if err := mysql.DeleteCollect(postId, userIds); err != nil {
-					logging.Logger.Error("async feed delete collectMsg fail",
-						zap.Error(err),
-						zap.Int64("post_id", postId))
-				}
-				delete(deleteCollect, postId)
-			}
-			muCollect.Unlock()
-		}
+	delivery, err := channel.Consume(queue, str.Empty,
+		false, false, false, false, nil)
+	if err != nil {
+		logging.Logger.Error("Failed to register a consumer", zap.Error(err), zap.String("queue", queue))
+		return
 	}
+	agg.Run(delivery)
 }
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"encoding/json"
+	"go.uber.org/zap"
+	"star/app/models"
+	"star/app/storage/mysql"
+	"star/app/utils/logging"
+	"time"
+)
+
+const (
+	// 去重记录的保留时间，远大于消息从投递到确认的时间
+	favorMessageRetention = 24 * time.Hour
+	purgeFavorBatch       = 1000
+)
+
+// decodeLike 解析点赞消息，兼容升级前只带帖子或评论id的消息，这类消息只更新点赞数
+func decodeLike(body []byte, legacy func() (int64, int64, error)) (*models.FavorDelta, error) {
+	var message models.LikeMessage
+	if err := json.Unmarshal(body, &message); err != nil {
+		return nil, err
+	}
+	if message.SourceId == 0 {
+		sourceId, star, err := legacy()
+		if err != nil {
+			return nil, err
+		}
+		return &models.FavorDelta{SourceId: sourceId, Value: star}, nil
+	}
+	return &models.FavorDelta{SourceId: message.SourceId, UserId: message.UserId, Value: message.Star}, nil
+}
+
+func decodePostLike(body []byte) (*models.FavorDelta, error) {
+	return decodeLike(body, func() (int64, int64, error) {
+		var post models.Post
+		err := json.Unmarshal(body, &post)
+		return post.PostId, int64(post.Star), err
+	})
+}
+
+func decodeCommentLike(body []byte) (*models.FavorDelta, error) {
+	return decodeLike(body, func() (int64, int64, error) {
+		var comment models.Comment
+		err := json.Unmarshal(body, &comment)
+		return comment.CommentId, int64(comment.Star), err
+	})
+}
+
+func decodeCollect(body []byte) (*models.FavorDelta, error) {
+	var collect models.Collect
+	if err := json.Unmarshal(body, &collect); err != nil {
+		return nil, err
+	}
+	return &models.FavorDelta{SourceId: collect.PostId, UserId: collect.UserId, Value: collect.Collection}, nil
+}
+
+// purgeFavorMessage 定期删除过期的去重记录
+func purgeFavorMessage() {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for range ticker.C {
+		before := time.Now().Add(-favorMessageRetention)
+		for {
+			n, err := mysql.PurgeFavorMessage(before, purgeFavorBatch)
+			if err != nil {
+				logging.Logger.Error("purge favor message error", zap.Error(err))
+				break
+			}
+			if n < purgeFavorBatch {
+				break
+			}
+		}
+	}
+}
@@ -34,8 +34,9 @@ import (
 
 // 每页帖子数
 const (
-	maxNewestPostLength = 400 //GetPostByTime List 的最大长度
-	defaultPostCount    = 20
+	maxNewestPostLength  = 400 //GetPostByTime List 的最大长度
+	defaultPostCount     = 20
+	maxRelationPostCount = 50 //关注流每页最多帖子数
 )
 
 type FeedSrv struct {
@@ -430,11 +431,19 @@ func (p *FeedSrv) GetCommunityPostByTime(ctx context.Context, req *feedPb.GetCom
 
 // GetPostByRelation 获取关注的人的帖子
 func (p *FeedSrv) GetPostByRelation(ctx context.Context, req *feedPb.GetPostByRelationRequest, resp *feedPb.GetPostByRelationResponse) error {
-	ctx, span := tracing.Tracer.Start(ctx, "GetPostByTimeService")
+	ctx, span := tracing.Tracer.Start(ctx, "GetPostByRelationService")
 	defer span.End()
 	logging.SetSpanWithHostname(span)
-	logger := logging.LogServiceWithTrace(span, "FeedService.GetPostByTime")
+	logger := logging.LogServiceWithTrace(span, "FeedService.GetPostByRelation")
 
+	limit := req.Limit
+	if limit <= 0 || limit > maxRelationPostCount {
+		limit = defaultPostCount
+	}
+	lastPostId := req.LastPostId
+	if lastPostId <= 0 {
+		lastPostId = math.MaxInt64
+	}
 	rResp, err := relationService.GetFollowList(ctx, &relationPb.GetFollowListRequest{
 		UserId: req.ActorId,
 	})
@@ -445,42 +454,112 @@ func (p *FeedSrv) GetPostByRelation(ctx context.Context, req *feedPb.GetPostByRe
 		logging.SetSpanError(span, err)
 		return str.ErrFeedError
 	}
-	followList := rResp.FollowList
-	var posts []*feedPb.Post
-	var wg sync.WaitGroup
-	var lock sync.Mutex
-	goroutineLimiter := make(chan struct{}, 15)
-	for _, follow := range followList {
-		goroutineLimiter <- struct{}{}
-		wg.Add(1)
-		go func(follow *userPb.User) {
-			defer func() {
-				wg.Done()
-				<-goroutineLimiter
-			}()
+	followIds := make([]int64, 0, len(rResp.FollowList))
+	for _, follow := range rResp.FollowList {
+		if follow == nil {
+			continue
+		}
+		followIds = append(followIds, follow.UserId)
+	}
+	if len(followIds) == 0 {
+		return nil
+	}
+	exist, err := redis.TimelineExists(ctx, req.ActorId)
+	if err != nil {
+		logger.Warn("redis check timeline exist error",
+			zap.Error(err),
+			zap.Int64("actorId", req.ActorId))
+	}
+	var posts []*models.Post
+	if exist {
+		posts, err = getPostByTimeline(ctx, req.ActorId, followIds, lastPostId, limit)
+	} else {
+		//收件箱不存在则全部读取时拉取
+		posts, err = mysql.GetPostByRelation(followIds, lastPostId, limit)
+	}
+	if err != nil {
+		logger.Error("get post by relation error",
+			zap.Error(err),
+			zap.Int64("actorId", req.ActorId),
+			zap.Int64("lastPostId", req.LastPostId))
+		logging.SetSpanError(span, err)
+		return str.ErrFeedError
+	}
+	if len(posts) == 0 {
+		return nil
+	}
+	resp.Posts, err = queryDetailed(ctx, posts, req.ActorId, logger)
+	if err != nil {
+		logger.Error("get feed detail error",
+			zap.Error(err),
+			zap.Int64("actorId", req.ActorId))
+		logging.SetSpanError(span, err)
+		return str.ErrFeedError
+	}
+	//不满一页说明已经没有更早的帖子了
+	if int64(len(posts)) == limit {
+		resp.NewPostId = posts[len(posts)-1].PostId
+	}
+	return nil
+}
 
-			pResp, err := publishService.ListPost(ctx, &publishPb.ListPostRequest{
-				ActorId: req.ActorId,
-				UserId:  follow.UserId,
-			})
-			if err != nil {
-				logger.Error("get follow publish list error",
-					zap.Error(err),
-					zap.Int64("followId", follow.UserId),
-					zap.Int64("ActorId", req.ActorId))
-				return
-			}
-			lock.Lock()
-			posts = slices.Concat(posts, pResp.Posts)
-			lock.Unlock()
-		}(follow)
+// getPostByTimeline 读取收件箱中推送的帖子并合并大V的帖子，收件箱不足一页时回源mysql
+func getPostByTimeline(ctx context.Context, actorId int64, followIds []int64, lastPostId int64, limit int64) ([]*models.Post, error) {
+	postIds, err := redis.GetTimeline(ctx, actorId, lastPostId, limit)
+	if err != nil {
+		return nil, err
 	}
-	wg.Wait()
-	sort.Slice(posts, func(i, j int) bool {
-		return posts[i].CreateTime > posts[j].CreateTime
+	posts, err := mysql.QueryPosts(postIds)
+	if err != nil {
+		return nil, err
+	}
+	bigVIds, err := redis.FilterBigV(ctx, followIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(bigVIds) > 0 {
+		bigVPosts, err := mysql.GetPostByRelation(bigVIds, lastPostId, limit)
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, bigVPosts...)
+	}
+	posts = mergePosts(posts, limit)
+	if int64(len(posts)) < limit {
+		cursor := lastPostId
+		if len(posts) > 0 {
+			cursor = posts[len(posts)-1].PostId
+		}
+		olderPosts, err := mysql.GetPostByRelation(followIds, cursor, limit-int64(len(posts)))
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, olderPosts...)
+	}
+	return posts, nil
+}
+
+// mergePosts 去重并按帖子id倒序排列，最多保留limit条
+func mergePosts(posts []*models.Post, limit int64) []*models.Post {
+	seen := make(map[int64]struct{}, len(posts))
+	merged := make([]*models.Post, 0, len(posts))
+	for _, post := range posts {
+		if !post.IsScan {
+			continue
+		}
+		if _, ok := seen[post.PostId]; ok {
+			continue
+		}
+		seen[post.PostId] = struct{}{}
+		merged = append(merged, post)
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].PostId > merged[j].PostId
 	})
-	resp.Posts = posts
-	return nil
+	if int64(len(merged)) > limit {
+		merged = merged[:limit]
+	}
+	return merged
 }
 
 // QueryPosts 查询帖子的大致信息
@@ -516,7 +595,9 @@ func queryDetailed(ctx context.Context, posts []*models.Post, actorId int64, log
 	communityMap := make(map[int64]*communityPb.Community)
 	for i, post := range posts {
 		respPosts[i] = &feedPb.Post{
-			PostId: post.PostId,
+			PostId:  post.PostId,
+			Content: post.Content,
+			Video:   post.Video,
 		}
 		if _, exist := userMap[post.UserId]; !exist {
 			userMap[post.UserId] = &userPb.User{}
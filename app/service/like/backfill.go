@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"star/app/extra/tracing"
+	"star/app/models"
+	"star/app/storage/mysql"
+	"star/app/storage/redis"
+	"star/app/utils/logging"
+	"sync/atomic"
+	"time"
+)
+
+// userLikeBackfill 将userLike之前的点赞补写到userLike的数据迁移，完成前对账不能以userLike修复点赞数
+// userCollectBackfill 将userCollect之前的收藏补写到userCollect的数据迁移，完成前对账不能以userCollect修复收藏数
+const (
+	userLikeBackfill     = "userLike_backfill"
+	userCollectBackfill  = "userCollect_backfill"
+	likeBackfillLockTime = 30 * time.Minute
+	likeBackfillRetry    = time.Minute
+)
+
+var (
+	userLikeBackfilled    atomic.Bool
+	userCollectBackfilled atomic.Bool
+)
+
+// migrationReady 数据迁移是否已完成，完成后缓存在done中
+func migrationReady(name string, done *atomic.Bool) (bool, error) {
+	if done.Load() {
+		return true, nil
+	}
+	ok, err := mysql.MigrationDone(name)
+	if err != nil {
+		return false, err
+	}
+	if ok {
+		done.Store(true)
+	}
+	return ok, nil
+}
+
+// likeRecordsReady userLike是否已补全，可以作为点赞数的依据
+func likeRecordsReady() (bool, error) {
+	return migrationReady(userLikeBackfill, &userLikeBackfilled)
+}
+
+// collectRecordsReady userCollect是否已补全，可以作为收藏数的依据
+func collectRecordsReady() (bool, error) {
+	return migrationReady(userCollectBackfill, &userCollectBackfilled)
+}
+
+// runFavorBackfill 补写userLike、userCollect之前的点赞和收藏记录，多个实例中只有一个执行，失败后重试直到完成
+func runFavorBackfill() {
+	for {
+		likeDone, err := backfillUserLike()
+		if err != nil {
+			logging.Logger.Error("backfill user like error", zap.Error(err))
+		}
+		collectDone, err := backfillUserCollect()
+		if err != nil {
+			logging.Logger.Error("backfill user collect error", zap.Error(err))
+		}
+		if likeDone && collectDone {
+			return
+		}
+		time.Sleep(likeBackfillRetry)
+	}
+}
+
+// backfillUserLike 帖子点赞从用户点赞帖子的缓存补写，评论点赞从点赞提醒补写，返回迁移是否已完成
+func backfillUserLike() (bool, error) {
+	ctx, span := tracing.Tracer.Start(context.Background(), "BackfillUserLike")
+	defer span.End()
+	logger := logging.LogServiceWithTrace(span, "LikeService.BackfillUserLike")
+
+	if done, err := likeRecordsReady(); err != nil || done {
+		return done, err
+	}
+	ok, err := redis.LockFavorBackfill(ctx, userLikeBackfill, likeBackfillLockTime)
+	if err != nil || !ok {
+		return false, err
+	}
+	var users, posts int
+	err = redis.ScanLikePosts(ctx, func(userId int64, records []*models.LikeRecord) error {
+		users++
+		posts += len(records)
+		return mysql.BackfillUserLike(models.LikeSourcePost, userId, records)
+	})
+	if err != nil {
+		logging.SetSpanError(span, err)
+		return false, err
+	}
+	comments, err := mysql.BackfillCommentLike()
+	if err != nil {
+		logging.SetSpanError(span, err)
+		return false, err
+	}
+	if err := mysql.FinishMigration(userLikeBackfill); err != nil {
+		logging.SetSpanError(span, err)
+		return false, err
+	}
+	userLikeBackfilled.Store(true)
+	logger.Info("user like backfilled",
+		zap.Int("users", users),
+		zap.Int("posts", posts),
+		zap.Int64("comments", comments))
+	return true, nil
+}
+
+// backfillUserCollect 收藏从用户收藏帖子的缓存补写，返回迁移是否已完成
+func backfillUserCollect() (bool, error) {
+	ctx, span := tracing.Tracer.Start(context.Background(), "BackfillUserCollect")
+	defer span.End()
+	logger := logging.LogServiceWithTrace(span, "LikeService.BackfillUserCollect")
+
+	if done, err := collectRecordsReady(); err != nil || done {
+		return done, err
+	}
+	ok, err := redis.LockFavorBackfill(ctx, userCollectBackfill, likeBackfillLockTime)
+	if err != nil || !ok {
+		return false, err
+	}
+	var users, posts int
+	err = redis.ScanCollectPosts(ctx, func(userId int64, records []*models.LikeRecord) error {
+		users++
+		posts += len(records)
+		return mysql.BackfillUserCollect(userId, records)
+	})
+	if err != nil {
+		logging.SetSpanError(span, err)
+		return false, err
+	}
+	if err := mysql.FinishMigration(userCollectBackfill); err != nil {
+		logging.SetSpanError(span, err)
+		return false, err
+	}
+	userCollectBackfilled.Store(true)
+	logger.Info("user collect backfilled",
+		zap.Int("users", users),
+		zap.Int("posts", posts))
+	return true, nil
+}
@@ -13,11 +13,12 @@ import (
 	"star/app/constant/str"
 	"star/app/extra/tracing"
 	"star/app/models"
-	"star/app/storage/cached"
 	"star/app/storage/mysql"
 	"star/app/storage/redis"
 	"star/app/utils/logging"
+	"star/app/utils/mention"
 	"star/app/utils/rabbitmq"
+	"star/app/utils/snowflake"
 	"star/proto/comment/commentPb"
 	"star/proto/feed/feedPb"
 	"star/proto/like/likePb"
@@ -76,13 +77,13 @@ func (l *LikeSrv) New() {
 	channel, err = conn.Channel()
 	failOnError(err, "like service failed to open a channel")
 
-	err = channel.ExchangeDeclare(str.FavorExchange, "topic", false, false, false, false, nil)
+	err = channel.ExchangeDeclare(str.FavorExchange, "topic", true, false, false, false, nil)
 	failOnError(err, "like service failed to declare an exchange")
 
-	_, err = channel.QueueDeclare(str.LikePost, false, false, false, false, nil)
+	_, err = channel.QueueDeclare(str.LikePost, true, false, false, false, nil)
 	failOnError(err, "like service failed to declare a like queue")
 
-	_, err = channel.QueueDeclare(str.LikeComment, false, false, false, false, nil)
+	_, err = channel.QueueDeclare(str.LikeComment, true, false, false, false, nil)
 	failOnError(err, "like service failed to declare a like queue")
 
 	err = channel.QueueBind(str.LikePost, str.RoutPost, str.FavorExchange, false, nil)
@@ -92,70 +93,59 @@ func (l *LikeSrv) New() {
 	failOnError(err, "like service failed to bind a queue to like")
 }
 
+// produceLike 发送点赞消息，由favorconsumer写入用户的点赞记录并更新点赞数
 func produceLike(ctx context.Context, req *likePb.LikeActionRequest) {
-	var err error
-	var star int
-	var msg []byte
-	if req.ActionTye == 1 {
-		star = 1
-	} else {
+	var star int64 = 1
+	if req.ActionTye != 1 {
 		star = -1
 	}
-	switch req.SourceType {
-	case post:
-		message := models.Post{
-			PostId: req.SourceId,
-			Star:   star,
-		}
-		msg, err = json.Marshal(message)
-		if err != nil {
-			logging.Logger.Error("produce feed like error,json marshal error",
-				zap.Error(err),
-				zap.Int64("postId", req.SourceId),
-				zap.Int64("actorId", req.UserId))
-			return
-		}
-		err = channel.Publish(
-			str.FavorExchange,
-			str.RoutPost,
-			false,
-			false,
-			amqp091.Publishing{
-				ContentType: "text/plain",
-				Body:        msg,
-			})
-	case comment:
-		message := models.Comment{
-			CommentId: req.SourceId,
-			Star:      star,
-		}
-		msg, err = json.Marshal(message)
-		if err != nil {
-			logging.Logger.Error("produce comment like error,json marshal error",
-				zap.Error(err),
-				zap.Int64("comment", req.SourceId),
-				zap.Int64("actorId", req.UserId))
-			return
-		}
-		err = channel.Publish(
-			str.FavorExchange,
-			str.RoutComment,
-			false,
-			false,
-			amqp091.Publishing{
-				ContentType: "text/plain",
-				Body:        msg,
-			})
-
+	routingKey := str.RoutPost
+	if req.SourceType == comment {
+		routingKey = str.RoutComment
 	}
+	msg, err := json.Marshal(models.LikeMessage{
+		SourceId: req.SourceId,
+		UserId:   req.UserId,
+		Star:     star,
+	})
 	if err != nil {
-		logging.Logger.Error("produce like error",
+		logging.Logger.Error("produce like error,json marshal error",
 			zap.Error(err),
 			zap.Int64("sourceId", req.SourceId),
 			zap.Int64("actorId", req.UserId))
 		return
 	}
+	err = channel.Publish(
+		str.FavorExchange,
+		routingKey,
+		false,
+		false,
+		amqp091.Publishing{
+			ContentType:  "text/plain",
+			DeliveryMode: amqp091.Persistent,
+			MessageId:    strconv.FormatInt(snowflake.GetID(), 10),
+			Body:         msg,
+		})
+	if err != nil {
+		logging.Logger.Error("produce like error",
+			zap.Error(err),
+			zap.Int64("sourceId", req.SourceId),
+			zap.Int64("actorId", req.UserId))
+	}
+}
 
+// ensureLiked 缓存中没有用户点赞的加载标记时，从mysql重建用户点赞的帖子或评论
+// 点赞记录由favorconsumer异步写入mysql，重建时保留缓存中已有的点赞
+func ensureLiked(ctx context.Context, sourceType int, userId int64) error {
+	loaded, err := redis.LikedLoaded(ctx, sourceType, userId)
+	if err != nil || loaded {
+		return err
+	}
+	records, err := mysql.ListUserLike(userId, sourceType)
+	if err != nil {
+		return err
+	}
+	return redis.WarmLiked(ctx, sourceType, userId, records)
 }
 
 // LikeAction 点赞
@@ -166,7 +156,7 @@ func (l *LikeSrv) LikeAction(ctx context.Context, req *likePb.LikeActionRequest,
 	logger := logging.LogServiceWithTrace(span, "LikeService.LikeAction")
 
 	var err error
-	switch req.ActionTye {
+	switch req.SourceType {
 	case post:
 		err = likePost(ctx, req, span, logger)
 	case comment:
@@ -212,6 +202,13 @@ func likePost(ctx context.Context, req *likePb.LikeActionRequest, span trace.Spa
 		logging.SetSpanError(span, err)
 		return err
 	}
+	if err := ensureLiked(ctx, models.LikeSourcePost, req.UserId); err != nil {
+		logger.Error("ensure user like posts error",
+			zap.Error(err),
+			zap.Int64("actorId", req.UserId))
+		logging.SetSpanError(span, err)
+		return err
+	}
 	postId := fmt.Sprintf("%d", req.SourceId)
 	//先检查是否重复点赞
 	value, err := redis.Client.ZScore(ctx, user_like_id, postId).Result()
@@ -250,7 +247,7 @@ func likePost(ctx context.Context, req *likePb.LikeActionRequest, span trace.Spa
 					SourceId:    req.SourceId,
 					SourceType:  "feed",
 					RemindType:  "like",
-					Content:     postInfo.Content,
+					Content:     mention.Excerpt(postInfo.Content, mention.ExcerptLength),
 					Url:         req.Url,
 					IsDeleted:   false,
 				})
@@ -288,7 +285,7 @@ func likePost(ctx context.Context, req *likePb.LikeActionRequest, span trace.Spa
 					SourceId:    req.SourceId,
 					SourceType:  "feed",
 					RemindType:  "like",
-					Content:     postInfo.Content,
+					Content:     mention.Excerpt(postInfo.Content, mention.ExcerptLength),
 					Url:         req.Url,
 					IsDeleted:   true,
 				})
@@ -322,8 +319,31 @@ func likeComment(ctx context.Context, req *likePb.LikeActionRequest, span trace.
 			zap.Int64("actorId", req.UserId))
 		return err
 	}
+	if err := ensureLiked(ctx, models.LikeSourceComment, req.UserId); err != nil {
+		logger.Error("ensure user like comments error",
+			zap.Error(err),
+			zap.Int64("actorId", req.UserId))
+		logging.SetSpanError(span, err)
+		return err
+	}
+	//先检查是否重复点赞，避免重复计数
+	liked, err := redis.IsLiked(ctx, models.LikeSourceComment, req.UserId, req.SourceId)
+	if err != nil {
+		logger.Error("redis is like comment error",
+			zap.Error(err),
+			zap.Int64("commentId", req.SourceId),
+			zap.Int64("actorId", req.UserId))
+		logging.SetSpanError(span, err)
+		return err
+	}
 	if req.ActionTye == 1 {
-		if err := redis.LikeCommentAction(ctx, commentInfo.CommentId, commentInfo.BeCommentId); err != nil {
+		if liked {
+			logger.Warn("user duplicate like comment",
+				zap.Int64("commentId", req.SourceId),
+				zap.Int64("userId", req.UserId))
+			return nil
+		}
+		if err := redis.LikeCommentAction(ctx, req.UserId, commentInfo.CommentId, commentInfo.UserId); err != nil {
 			logger.Error("redis user like comment error",
 				zap.Error(err),
 				zap.Int64("post_id", req.SourceId),
@@ -336,9 +356,9 @@ func likeComment(ctx context.Context, req *likePb.LikeActionRequest, span trace.
 				SenderId:    req.UserId,
 				RecipientId: commentInfo.UserId,
 				SourceId:    req.SourceId,
-				SourceType:  "feed",
+				SourceType:  "comment",
 				RemindType:  "like",
-				Content:     commentInfo.Content,
+				Content:     mention.Excerpt(commentInfo.Content, mention.ExcerptLength),
 				Url:         req.Url,
 				IsDeleted:   false,
 			})
@@ -352,7 +372,13 @@ func likeComment(ctx context.Context, req *likePb.LikeActionRequest, span trace.
 		}()
 
 	} else {
-		if err := redis.UnLikeCommentAction(ctx, commentInfo.CommentId, commentInfo.UserId); err != nil {
+		if !liked {
+			logger.Warn("user did not like comment, cancel liking",
+				zap.Int64("commentId", req.SourceId),
+				zap.Int64("userId", req.UserId))
+			return nil
+		}
+		if err := redis.UnLikeCommentAction(ctx, req.UserId, commentInfo.CommentId, commentInfo.UserId); err != nil {
 			logger.Error("redis user cancel like feed error",
 				zap.Error(err),
 				zap.Int64("post_id", req.SourceId),
@@ -364,9 +390,9 @@ func likeComment(ctx context.Context, req *likePb.LikeActionRequest, span trace.
 				SenderId:    req.UserId,
 				RecipientId: commentInfo.UserId,
 				SourceId:    req.SourceId,
-				SourceType:  "feed",
+				SourceType:  "comment",
 				RemindType:  "like",
-				Content:     commentInfo.Content,
+				Content:     mention.Excerpt(commentInfo.Content, mention.ExcerptLength),
 				Url:         req.Url,
 				IsDeleted:   true,
 			})
@@ -420,6 +446,13 @@ func (l *LikeSrv) LikeList(ctx context.Context, req *likePb.LikeListRequest, res
 	logging.SetSpanWithHostname(span)
 	logger := logging.LogServiceWithTrace(span, "LikeService.LikeList")
 
+	if err := ensureLiked(ctx, models.LikeSourcePost, req.UserId); err != nil {
+		logger.Error("ensure user like posts error",
+			zap.Error(err),
+			zap.Int64("user_id", req.UserId))
+		logging.SetSpanError(span, err)
+		return str.ErrLikeError
+	}
 	key := fmt.Sprintf("user:%d:like_posts", req.UserId)
 	postIdsStr, err := redis.Client.ZRevRange(ctx, key, 0, -1).Result()
 	if err != nil {
@@ -480,7 +513,7 @@ func (l *LikeSrv) GetLikeCount(ctx context.Context, req *likePb.GetLikeCountRequ
 }
 
 func countPostLike(ctx context.Context, postId int64, span trace.Span, logger *zap.Logger) (count int64, err error) {
-	key := fmt.Sprintf("feed:%d:liked_count", postId)
+	key := redis.FavorCountKey(models.FavorPostLike, postId)
 	countStr, err := redis.Client.Get(ctx, key).Result()
 	if err != nil && !errors.Is(err, redis2.Nil) {
 		logger.Error("redis get feed like count error",
@@ -505,7 +538,7 @@ func countPostLike(ctx context.Context, postId int64, span trace.Span, logger *z
 }
 
 func countCommentLike(ctx context.Context, commentId int64, span trace.Span, logger *zap.Logger) (count int64, err error) {
-	key := fmt.Sprintf("comment:%d:liked_count", commentId)
+	key := redis.FavorCountKey(models.FavorCommentLike, commentId)
 	countStr, err := redis.Client.Get(ctx, key).Result()
 	if err != nil && !errors.Is(err, redis2.Nil) {
 		logger.Error("redis get comment like count error",
@@ -534,6 +567,13 @@ func (l *LikeSrv) GetUserLikeCount(ctx context.Context, req *likePb.GetUserLikeC
 	logging.SetSpanWithHostname(span)
 	logger := logging.LogServiceWithTrace(span, "LikeService.GetUserLikeCount")
 
+	if err := ensureLiked(ctx, models.LikeSourcePost, req.UserId); err != nil {
+		logger.Error("ensure user like posts error",
+			zap.Error(err),
+			zap.Int64("user_id", req.UserId))
+		logging.SetSpanError(span, err)
+		return str.ErrLikeError
+	}
 	key := fmt.Sprintf("user:%d:like_posts", req.UserId)
 	count, err := redis.Client.ZCard(ctx, key).Result()
 	if err != nil && !errors.Is(err, redis2.Nil) {
@@ -577,6 +617,13 @@ func (l *LikeSrv) IsLike(ctx context.Context, req *likePb.IsLikeRequest, resp *l
 }
 
 func isLikePost(ctx context.Context, actorId, postId int64, span trace.Span, logger *zap.Logger) (bool, error) {
+	if err := ensureLiked(ctx, models.LikeSourcePost, actorId); err != nil {
+		logger.Error("ensure user like posts error",
+			zap.Error(err),
+			zap.Int64("userId", actorId))
+		logging.SetSpanError(span, err)
+		return false, err
+	}
 	key := fmt.Sprintf("user:%d:like_posts", actorId)
 	postIdStr := fmt.Sprintf("%d", postId)
 	ok, err := redis.Client.ZScore(ctx, key, postIdStr).Result()
@@ -599,10 +646,14 @@ func isLikePost(ctx context.Context, actorId, postId int64, span trace.Span, log
 }
 
 func isLikeComment(ctx context.Context, actorId, commentId int64, span trace.Span, logger *zap.Logger) (bool, error) {
-	cacheKey := fmt.Sprintf("IsLike_actorId:%d_commentId:%d", actorId, commentId)
-	countStr, err := cached.GetWithFunc(ctx, cacheKey, func(key string) (string, error) {
-		return mysql.IsLikeComment(actorId, commentId)
-	})
+	if err := ensureLiked(ctx, models.LikeSourceComment, actorId); err != nil {
+		logger.Error("ensure user like comments error",
+			zap.Error(err),
+			zap.Int64("userId", actorId))
+		logging.SetSpanError(span, err)
+		return false, err
+	}
+	liked, err := redis.IsLiked(ctx, models.LikeSourceComment, actorId, commentId)
 	if err != nil {
 		logger.Error("is Like comment error",
 			zap.Error(err),
@@ -611,5 +662,5 @@ func isLikeComment(ctx context.Context, actorId, commentId int64, span trace.Spa
 		logging.SetSpanError(span, err)
 		return false, err
 	}
-	return countStr != "0", nil
+	return liked, nil
 }
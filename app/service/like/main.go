@@ -36,6 +36,8 @@ func main() {
 		}
 	}()
 	likeSrvIns.New()
+	go runFavorBackfill()
+	go runReconcileJob()
 	//etcd注册件
 	etcdReg := etcd.NewRegistry(
 		registry.Addrs(fmt.Sprintf("%s:%d", settings.Conf.EtcdHost, settings.Conf.EtcdPort)),
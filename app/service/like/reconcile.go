@@ -0,0 +1,279 @@
+package main
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"star/app/constant/settings"
+	"star/app/constant/str"
+	"star/app/extra/tracing"
+	"star/app/models"
+	"star/app/storage/mysql"
+	"star/app/storage/redis"
+	"star/app/utils/logging"
+	"star/proto/like/likePb"
+	"time"
+)
+
+const (
+	// 点赞、收藏的计数经消息队列异步写入数据库，计数变化后等待一段时间再对账，避免把未写入的变化当作偏差
+	reconcileSettle    = 2 * time.Minute
+	reconcileInterval  = time.Minute
+	reconcileBatchSize = 200
+	// 定时对账每种计数每次最多处理的批次数
+	maxReconcileBatches = 10
+	maxReconcileLimit   = 1000
+	maxReportDrifts     = 100
+
+	repairMysql = "mysql"
+	repairRedis = "redis"
+	repairAll   = "all"
+)
+
+var favorKinds = []string{models.FavorPostLike, models.FavorCommentLike, models.FavorPostCollect}
+
+// checkRepair 点赞数以userLike为依据，收藏数以userCollect为依据，补全之前只报告偏差不修复
+func checkRepair(kind string, repair string) (string, error) {
+	if repair == "" {
+		return repair, nil
+	}
+	ready := likeRecordsReady
+	if kind == models.FavorPostCollect {
+		ready = collectRecordsReady
+	}
+	ok, err := ready()
+	if err != nil || !ok {
+		return "", err
+	}
+	return repair, nil
+}
+
+// reconcileBatch 对账一批id，从点赞、收藏记录统计计数并与数据库和缓存中的计数比较，按repair修复偏差
+func reconcileBatch(ctx context.Context, kind string, ids []int64, repair string, result *likePb.ReconcileResult) error {
+	changed, err := redis.GetFavorChangedTime(ctx, kind, ids)
+	if err != nil {
+		return err
+	}
+	settled := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if changedAt, ok := changed[id]; ok && time.Since(changedAt) < reconcileSettle {
+			result.Skipped++
+			continue
+		}
+		settled = append(settled, id)
+	}
+	expected, err := mysql.CountFavorRelation(kind, settled)
+	if err != nil {
+		return err
+	}
+	stored, err := mysql.GetFavorCount(kind, settled)
+	if err != nil {
+		return err
+	}
+	cached, err := redis.GetFavorCounts(ctx, kind, settled)
+	if err != nil {
+		return err
+	}
+
+	mysqlTarget := make(map[int64]int64)
+	redisTarget := make(map[int64]int64)
+	for _, id := range settled {
+		mysqlCount, ok := stored[id]
+		if !ok {
+			//帖子或评论已不存在
+			result.Skipped++
+			continue
+		}
+		result.Checked++
+		want := expected[id]
+		redisCount, cachedOk := cached[id]
+		mysqlDrift := mysqlCount != want
+		//计数为0的帖子可能从未缓存过
+		redisDrift := cachedOk && redisCount != want || !cachedOk && want != 0
+		if !mysqlDrift && !redisDrift {
+			continue
+		}
+		result.Drifted++
+		if len(result.Drifts) < maxReportDrifts {
+			result.Drifts = append(result.Drifts, &likePb.FavorDrift{
+				SourceId:     id,
+				Expected:     want,
+				MysqlCount:   mysqlCount,
+				RedisCount:   redisCount,
+				RedisMissing: !cachedOk,
+			})
+		}
+		if mysqlDrift {
+			mysqlTarget[id] = want
+		}
+		if redisDrift {
+			redisTarget[id] = want
+		}
+	}
+
+	if repair == repairMysql || repair == repairAll {
+		n, err := mysql.SetFavorCount(kind, stored, mysqlTarget)
+		if err != nil {
+			return err
+		}
+		result.RepairedMysql += n
+	}
+	if repair == repairRedis || repair == repairAll {
+		n, err := redis.SetFavorCounts(ctx, kind, cached, redisTarget)
+		if err != nil {
+			return err
+		}
+		result.RepairedRedis += n
+	}
+	return nil
+}
+
+// reconcileChanged 对账最近计数有变化的id，两侧都修复后才删除变化记录，consume为true时报告后即删除
+func reconcileChanged(ctx context.Context, kind string, limit int64, repair string, consume bool, result *likePb.ReconcileResult) error {
+	before := time.Now().Add(-reconcileSettle)
+	ids, err := redis.ListChangedFavor(ctx, kind, before, limit)
+	if err != nil {
+		return err
+	}
+	if err := reconcileBatch(ctx, kind, ids, repair, result); err != nil {
+		return err
+	}
+	if repair != repairAll && !consume {
+		return nil
+	}
+	return redis.ClearChangedFavor(ctx, kind, ids, before)
+}
+
+// reconcileFull 按id顺序对账cursor之后的帖子或评论
+func reconcileFull(ctx context.Context, kind string, cursor int64, limit int64, repair string, result *likePb.ReconcileResult) error {
+	ids, err := mysql.ListFavorSourceId(kind, cursor, int(limit))
+	if err != nil {
+		return err
+	}
+	if err := reconcileBatch(ctx, kind, ids, repair, result); err != nil {
+		return err
+	}
+	if int64(len(ids)) == limit {
+		result.NextCursor = ids[len(ids)-1]
+	}
+	return nil
+}
+
+// ReconcileFavorCount 对账帖子、评论的点赞数和帖子收藏数
+func (l *LikeSrv) ReconcileFavorCount(ctx context.Context, req *likePb.ReconcileFavorCountRequest, resp *likePb.ReconcileFavorCountResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "ReconcileFavorCountService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "LikeService.ReconcileFavorCount")
+
+	kinds := favorKinds
+	if req.Kind != "" {
+		kinds = []string{req.Kind}
+	}
+	switch req.Repair {
+	case "", repairMysql, repairRedis, repairAll:
+	default:
+		return str.ErrInvalidParam
+	}
+	limit := req.Limit
+	if limit <= 0 || limit > maxReconcileLimit {
+		limit = reconcileBatchSize
+	}
+	for _, kind := range kinds {
+		result := &likePb.ReconcileResult{Kind: kind}
+		if kind != models.FavorPostLike && kind != models.FavorCommentLike && kind != models.FavorPostCollect {
+			return str.ErrInvalidParam
+		}
+		repair, err := checkRepair(kind, req.Repair)
+		if err == nil {
+			switch {
+			case len(req.SourceIds) > 0:
+				if len(req.SourceIds) > maxReconcileLimit {
+					return str.ErrInvalidParam
+				}
+				err = reconcileBatch(ctx, kind, req.SourceIds, repair, result)
+			case req.Full:
+				err = reconcileFull(ctx, kind, req.Cursor, limit, repair, result)
+			default:
+				err = reconcileChanged(ctx, kind, limit, repair, false, result)
+			}
+		}
+		if err != nil {
+			logger.Error("reconcile favor count error",
+				zap.Error(err),
+				zap.String("kind", kind),
+				zap.String("repair", repair))
+			logging.SetSpanError(span, err)
+			return str.ErrLikeError
+		}
+		logger.Info("favor count reconciled",
+			zap.String("kind", kind),
+			zap.Int64("checked", result.Checked),
+			zap.Int64("drifted", result.Drifted),
+			zap.Int64("repairedMysql", result.RepairedMysql),
+			zap.Int64("repairedRedis", result.RepairedRedis))
+		resp.Results = append(resp.Results, result)
+	}
+	return nil
+}
+
+// runReconcileJob 定时对账最近计数有变化的id，默认只报告偏差，配置auto_repair后修复两侧的偏差，多个实例中每次只有一个执行
+func runReconcileJob() {
+	ticker := time.NewTicker(reconcileInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		reconcileJob()
+	}
+}
+
+func reconcileJob() {
+	ctx, span := tracing.Tracer.Start(context.Background(), "ReconcileFavorCountJob")
+	defer span.End()
+	logger := logging.LogServiceWithTrace(span, "LikeService.ReconcileJob")
+
+	ok, err := redis.LockFavorReconcile(ctx, reconcileInterval)
+	if err != nil {
+		logger.Error("lock favor reconcile error", zap.Error(err))
+		return
+	}
+	if !ok {
+		return
+	}
+	repair := ""
+	if settings.Conf.FavorConfig.GetAutoRepair() {
+		repair = repairAll
+	}
+	for _, kind := range favorKinds {
+		//userLike、userCollect补全之前不对账对应的计数，变化记录保留到补全之后再处理
+		ready, err := checkRepair(kind, repairAll)
+		if err != nil {
+			logger.Error("check like records error", zap.Error(err))
+			continue
+		}
+		if ready != repairAll {
+			continue
+		}
+		result := &likePb.ReconcileResult{Kind: kind}
+		for i := 0; i < maxReconcileBatches; i++ {
+			checked := result.Checked + result.Skipped
+			if err := reconcileChanged(ctx, kind, reconcileBatchSize, repair, true, result); err != nil {
+				logger.Error("reconcile favor count error",
+					zap.Error(err),
+					zap.String("kind", kind))
+				logging.SetSpanError(span, err)
+				break
+			}
+			if result.Checked+result.Skipped-checked < reconcileBatchSize {
+				break
+			}
+		}
+		for _, drift := range result.Drifts {
+			logger.Warn("favor count drift",
+				zap.String("kind", kind),
+				zap.Int64("sourceId", drift.SourceId),
+				zap.Int64("expected", drift.Expected),
+				zap.Int64("mysqlCount", drift.MysqlCount),
+				zap.Int64("redisCount", drift.RedisCount),
+				zap.Bool("redisMissing", drift.RedisMissing))
+		}
+	}
+}
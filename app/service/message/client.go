@@ -1,10 +1,10 @@
 package main
 
 import (
+	"encoding/json"
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	"go.uber.org/zap"
-	"star/app/constant/str"
-	"star/app/models"
 	"star/app/utils/logging"
 	"time"
 )
@@ -20,31 +20,87 @@ const (
 	pingPeriod = (pongWait * 9) / 10
 )
 
+// 推送给客户端的消息帧类型
+const (
+	framePrivate = "private"
+	frameGroup   = "group"
+	frameTyping  = "typing"
+	frameRead    = "read"
+	frameAck     = "ack"
+	frameError   = "error"
+)
+
+// 客户端上行消息帧类型
+const (
+	actionSendPrivate = "send_private"
+	actionTyping      = "typing"
+	actionRead        = "read"
+	actionJoinGroup   = "join_group"
+	actionLeaveGroup  = "leave_group"
+	actionSendGroup   = "send_group"
+)
+
+// Frame 推送给客户端的消息帧
+type Frame struct {
+	Type string      `json:"type"`
+	Data interface{} `json:"data"`
+}
+
+// inboundFrame 客户端上行的消息帧，typing和read中的recipientId为会话的对方
+type inboundFrame struct {
+	Type          string `json:"type"`
+	ClientMsgId   string `json:"clientMsgId"` //客户端生成的消息id，用于ack和重发去重
+	RecipientId   int64  `json:"recipientId"`
+	PrivateChatId int64  `json:"privateChatId"`
+	CommunityId   int64  `json:"communityId"`
+	MessageId     int64  `json:"messageId"` //read时为已读到的消息id
+	Content       string `json:"content"`
+}
+
 type Client struct {
 	userId int64
-	send   chan *models.PrivateMessage //储存发送信息
-	conn   *websocket.Conn             //websocket连接
+	connId string             //连接id，用于区分同一用户的多个连接
+	send   chan *Frame        //储存发送信息
+	conn   *websocket.Conn    //websocket连接
+	rooms  map[int64]struct{} //已加入的社区群聊，由manager加锁维护
+
+	lastTyping time.Time //上次发送输入状态的时间，只在read协程中访问
 }
 
 func NewClient(conn *websocket.Conn, userId int64) *Client {
 	return &Client{
 		userId: userId,
-		send:   make(chan *models.PrivateMessage, 256),
+		connId: uuid.New().String(),
+		send:   make(chan *Frame, 256),
 		conn:   conn,
+		rooms:  make(map[int64]struct{}),
 	}
 }
 
 func (c *Client) read() {
+	defer func() {
+		manager.Disconnect <- c
+	}()
+	c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
 	for {
-		messageType, _, err := c.conn.ReadMessage()
+		_, data, err := c.conn.ReadMessage()
 		if err != nil {
-			if messageType == -1 && websocket.IsCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure, websocket.CloseNoStatusReceived) {
-				manager.Disconnect <- c
-				return
-			} else if messageType != websocket.PingMessage {
-				return
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure, websocket.CloseNoStatusReceived) {
+				logging.Logger.Warn("read message error",
+					zap.Error(err),
+					zap.Int64("userId", c.userId))
 			}
+			return
+		}
+		frame := new(inboundFrame)
+		if err := json.Unmarshal(data, frame); err != nil {
+			manager.SendToClient(c, &Frame{Type: frameError, Data: "invalid frame"})
+			continue
 		}
+		c.handle(frame)
 	}
 }
 
@@ -56,29 +112,32 @@ func (c *Client) write() {
 	}()
 	for {
 		select {
-		case message, ok := <-c.send:
+		case frame, ok := <-c.send:
 			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
 			if !ok {
 				//send通道已关闭
 				c.conn.WriteMessage(websocket.CloseMessage, []byte{})
 				return
 			}
-			if err := c.conn.WriteJSON(message); err != nil {
+			if err := c.conn.WriteJSON(frame); err != nil {
 				logging.Logger.Error("write message error",
 					zap.Error(err),
-					zap.Int64("privateMessageId", message.Id),
-					zap.Int64("privateChatId", message.PrivateChatId),
-					zap.Int64("senderId", message.SenderId),
-					zap.Int64("recipientId", message.RecipientId),
-					zap.String("content", message.Content),
-					zap.String("sendTime", message.SendTime.Format(str.ParseTimeFormat)),
-					zap.Bool("status", message.Status))
+					zap.Int64("userId", c.userId),
+					zap.String("type", frame.Type),
+					zap.Any("data", frame.Data))
+				return
 			}
 		case <-ticker.C:
 			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
 			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
 				return
 			}
+			//连接存活时续期，实例异常退出后连接记录会自动过期
+			if err := SaveServiceId(c); err != nil {
+				logging.Logger.Error("refresh service id failed",
+					zap.Error(err),
+					zap.Int64("userId", c.userId))
+			}
 		}
 
 	}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
 	"go.uber.org/zap"
@@ -24,43 +25,40 @@ var upgrader = websocket.Upgrader{
 }
 
 func Run(c *gin.Context) {
-	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
-	if err != nil {
-		logging.Logger.Error("upgrader connection failed", zap.Error(err))
-		str.Response(c, err, str.Empty, nil)
-		return
-	}
-	//设置最大读取消息大小
-	conn.SetReadLimit(maxMessageSize)
 	userId, err := request.GetUserId(c)
 	if err != nil {
 		logging.Logger.Error("get user id failed", zap.Error(err))
-		str.Response(c, err, str.Empty, nil)
+		str.Response(c, err, nil)
 		return
 	}
-	instanceId, err := getHostnameInstanceID()
+	//升级失败时upgrader已向客户端返回错误
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
 	if err != nil {
-		logging.Logger.Error("get hostname instance id failed", zap.Error(err))
-		str.Response(c, str.ErrServiceBusy, str.Empty, nil)
-		return
-	}
-	if err := SaveServiceId(userId, instanceId); err != nil {
-		logging.Logger.Error("save service id failed", zap.Error(err))
-		str.Response(c, err, str.Empty, nil)
+		logging.Logger.Error("upgrader connection failed", zap.Error(err))
 		return
 	}
+	//设置最大读取消息大小
+	conn.SetReadLimit(maxMessageSize)
 	client := NewClient(conn, userId)
 
+	//同步注册连接后再开始读写，读写协程发出的断开事件一定在注册之后处理
+	manager.EventConnect(client)
+	if err := SaveServiceId(client); err != nil {
+		logging.Logger.Error("save service id failed",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		manager.EventDisConnect(client)
+		return
+	}
 	go client.read()
 	go client.write()
-	manager.Connect <- client
 }
 
-// 获取主机名作为实例 ID
-func getHostnameInstanceID() (string, error) {
+// 获取实例 ID，同一主机上可能运行多个实例，因此加上进程号
+func getInstanceId() (string, error) {
 	hostname, err := os.Hostname()
 	if err != nil {
 		return "", err
 	}
-	return hostname, nil
+	return fmt.Sprintf("%s-%d", hostname, os.Getpid()), nil
 }
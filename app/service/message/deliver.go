@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"go.uber.org/zap"
+	"star/app/storage/redis"
+	"star/app/utils/logging"
+)
+
+// 当前消息服务实例的id，连接所在实例记录在redis中
+var instanceId string
+
+// routedFrame 投递到其他实例的消息帧
+type routedFrame struct {
+	UserId int64           `json:"userId"`
+	Type   string          `json:"type"`
+	Data   json.RawMessage `json:"data"`
+}
+
+// deliver 将消息帧推送给用户在所有实例上的连接，用户不在线时返回false
+func deliver(ctx context.Context, userId int64, frame *Frame) (bool, error) {
+	instances, err := redis.GetWsInstances(ctx, userId)
+	if err != nil {
+		return false, err
+	}
+	var payload []byte
+	for _, instance := range instances {
+		if instance == instanceId {
+			manager.SendTo(userId, frame)
+			continue
+		}
+		if payload == nil {
+			data, err := json.Marshal(frame.Data)
+			if err != nil {
+				return false, err
+			}
+			payload, err = json.Marshal(&routedFrame{UserId: userId, Type: frame.Type, Data: data})
+			if err != nil {
+				return false, err
+			}
+		}
+		if err := redis.PublishToInstance(ctx, instance, payload); err != nil {
+			return false, err
+		}
+	}
+	return len(instances) > 0, nil
+}
+
+// subscribeInstance 订阅当前实例的投递频道，推送给本实例上的连接
+func subscribeInstance() {
+	pubsub := redis.Client.Subscribe(context.Background(), redis.PrivateChatChannel(instanceId))
+	defer pubsub.Close()
+	for msg := range pubsub.Channel() {
+		routed := new(routedFrame)
+		if err := json.Unmarshal([]byte(msg.Payload), routed); err != nil {
+			logging.Logger.Error("json unmarshal routed frame error",
+				zap.Error(err),
+				zap.String("payload", msg.Payload))
+			continue
+		}
+		manager.SendTo(routed.UserId, &Frame{Type: routed.Type, Data: routed.Data})
+	}
+}
@@ -0,0 +1,384 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-redis/redis_rate/v10"
+	"github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"math"
+	"star/app/constant/str"
+	"star/app/extra/tracing"
+	"star/app/models"
+	"star/app/storage/mysql"
+	"star/app/storage/redis"
+	"star/app/utils/logging"
+	"star/app/utils/rabbitmq"
+	"star/app/utils/snowflake"
+	"star/proto/community/communityPb"
+	"star/proto/message/messagePb"
+	"star/proto/user/userPb"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	sendGroupMsgMaxQPS    = 3
+	maxGroupMessageLength = 255 //与group_messages.content长度一致
+	maxLoadGroupMsgNumber = 100
+)
+
+func sendGroupMessageLimitKey(userId int64) string {
+	return fmt.Sprintf("sendGroupMessage_limiter:%d", userId)
+}
+
+// checkCommunityMember 只有关注了社区的用户才能加入群聊
+func checkCommunityMember(ctx context.Context, userId int64, communityId int64, logger *zap.Logger) error {
+	resp, err := communityService.IsFollowCommunity(ctx, &communityPb.IsFollowCommunityRequest{
+		ActorId:     userId,
+		CommunityId: communityId,
+	})
+	if err != nil {
+		logger.Error("community service error",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.Int64("communityId", communityId))
+		return str.ErrMessageError
+	}
+	if !resp.Result {
+		return str.ErrNotCommunityMember
+	}
+	return nil
+}
+
+// joinGroup 处理websocket上行的加入群聊请求
+func joinGroup(client *Client, communityId int64) error {
+	ctx, span := tracing.Tracer.Start(context.Background(), "JoinGroupService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "MessageService.JoinGroup")
+
+	if err := checkCommunityMember(ctx, client.userId, communityId, logger); err != nil {
+		logging.SetSpanError(span, err)
+		return err
+	}
+	manager.Join(client, communityId)
+	manager.SendToClient(client, &Frame{Type: actionJoinGroup, Data: communityId})
+	return nil
+}
+
+// sendGroup 处理websocket上行的群聊消息，返回消息id
+func sendGroup(client *Client, frame *inboundFrame) (int64, error) {
+	ctx, span := tracing.Tracer.Start(context.Background(), "SendGroupService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "MessageService.SendGroup")
+
+	return dedupeSend(ctx, client.userId, frame.ClientMsgId, logger, func() (int64, error) {
+		message, err := sendGroupMessage(ctx, client.userId, frame.CommunityId, frame.Content, span, logger)
+		if err != nil {
+			return 0, err
+		}
+		return message.ChatId, nil
+	})
+}
+
+// SendGroupMessage 发送群聊消息
+func (m *MessageSrv) SendGroupMessage(ctx context.Context, req *messagePb.SendGroupMessageRequest, resp *messagePb.SendGroupMessageResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "SendGroupMessageService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "MessageService.SendGroupMessage")
+
+	message, err := sendGroupMessage(ctx, req.SenderId, req.CommunityId, req.Content, span, logger)
+	if err != nil {
+		return err
+	}
+	resp.Message = convertGroupMessageToPB(message)
+	return nil
+}
+
+// sendGroupMessage 保存群聊消息并广播给所有消息服务实例上的在线成员
+func sendGroupMessage(ctx context.Context, senderId int64, communityId int64, content string, span trace.Span, logger *zap.Logger) (*models.GroupMessage, error) {
+	if strings.TrimSpace(content) == str.Empty || utf8.RuneCountInString(content) > maxGroupMessageLength {
+		return nil, str.ErrInvalidMessage
+	}
+	limiter := redis_rate.NewLimiter(redis.Client)
+	limitRes, err := limiter.Allow(ctx, sendGroupMessageLimitKey(senderId), redis_rate.PerSecond(sendGroupMsgMaxQPS))
+	if err != nil {
+		logger.Error("sendGroupMsg limit error",
+			zap.Error(err),
+			zap.Int64("senderId", senderId),
+			zap.Int64("communityId", communityId))
+		logging.SetSpanError(span, err)
+		return nil, str.ErrMessageError
+	}
+	if limitRes.Allowed == 0 {
+		logger.Warn("user send group msg too frequently",
+			zap.Int64("senderId", senderId),
+			zap.Int64("communityId", communityId))
+		return nil, str.ErrRequestTooFrequently
+	}
+	if err := checkCommunityMember(ctx, senderId, communityId, logger); err != nil {
+		logging.SetSpanError(span, err)
+		return nil, err
+	}
+	senderResp, err := userService.GetUserInfo(ctx, &userPb.GetUserInfoRequest{
+		UserId: senderId,
+	})
+	if err != nil {
+		logger.Error("get sender user info error",
+			zap.Error(err),
+			zap.Int64("senderId", senderId))
+		logging.SetSpanError(span, err)
+		return nil, str.ErrMessageError
+	}
+
+	message := &models.GroupMessage{
+		ChatId:      snowflake.GetID(),
+		CommunityId: communityId,
+		SdUserId:    senderId,
+		UserName:    senderResp.User.Username,
+		Img:         senderResp.User.GetAvatar(),
+		Content:     content,
+		SendTime:    time.Now().UTC(),
+	}
+	if err := redis.SaveGroupMessage(ctx, message); err != nil {
+		logger.Error("redis save group message error",
+			zap.Error(err),
+			zap.Any("message", message))
+		logging.SetSpanError(span, err)
+		return nil, str.ErrMessageError
+	}
+	body, err := json.Marshal(message)
+	if err != nil {
+		logger.Error("json marshal message error",
+			zap.Error(err),
+			zap.Any("message", message))
+		logging.SetSpanError(span, err)
+		return nil, str.ErrMessageError
+	}
+	header := rabbitmq.InjectAMQPHeaders(ctx)
+	err = channel.PublishWithContext(
+		ctx,
+		str.MessageExchange,
+		str.RoutGroupMsg,
+		false,
+		false,
+		amqp091.Publishing{
+			DeliveryMode: amqp091.Persistent,
+			ContentType:  "text/plain",
+			Body:         body,
+			Headers:      header,
+		},
+	)
+	if err != nil {
+		logger.Error("publish message error",
+			zap.Error(err),
+			zap.Any("message", message))
+		logging.SetSpanError(span, err)
+		return nil, str.ErrMessageError
+	}
+	if err := redis.PublishGroupMessage(ctx, message); err != nil {
+		//消息已保存，成员可以通过加载历史消息看到
+		logger.Error("redis publish group message error",
+			zap.Error(err),
+			zap.Any("message", message))
+	}
+	//自己发送的消息视为已读
+	if err := redis.SetGroupRead(ctx, senderId, communityId, message.ChatId); err != nil {
+		logger.Error("redis set group read error",
+			zap.Error(err),
+			zap.Int64("userId", senderId),
+			zap.Int64("communityId", communityId))
+	}
+	return message, nil
+}
+
+// subscribeGroupMessage 订阅群聊广播，推送给本实例上加入该社区群聊的连接
+func subscribeGroupMessage() {
+	pubsub := redis.Client.Subscribe(context.Background(), redis.GroupChatChannel)
+	defer pubsub.Close()
+	for msg := range pubsub.Channel() {
+		message := new(models.GroupMessage)
+		if err := json.Unmarshal([]byte(msg.Payload), message); err != nil {
+			logging.Logger.Error("json unmarshal group message error",
+				zap.Error(err),
+				zap.String("payload", msg.Payload))
+			continue
+		}
+		manager.Broadcast(message.CommunityId, &Frame{Type: frameGroup, Data: message})
+	}
+}
+
+// LoadGroupMessage 按时间倒序分页加载群聊消息，加载第一页时更新已读位置
+func (m *MessageSrv) LoadGroupMessage(ctx context.Context, req *messagePb.LoadGroupMessageRequest, resp *messagePb.LoadGroupMessageResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "LoadGroupMessageService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "MessageService.LoadGroupMessage")
+
+	if err := checkCommunityMember(ctx, req.UserId, req.CommunityId, logger); err != nil {
+		logging.SetSpanError(span, err)
+		return err
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = str.DefaultLoadMessageNumber
+	}
+	limit = min(limit, maxLoadGroupMsgNumber)
+	lastChatId := req.LastChatId
+	if lastChatId <= 0 {
+		lastChatId = math.MaxInt64
+	}
+	messages, err := redis.LoadGroupMessage(ctx, req.CommunityId, lastChatId, limit)
+	if err != nil {
+		logger.Error("redis load group message error",
+			zap.Error(err),
+			zap.Int64("communityId", req.CommunityId),
+			zap.Int64("lastChatId", req.LastChatId))
+		logging.SetSpanError(span, err)
+		return str.ErrMessageError
+	}
+	//缓存只保留最近的消息，不足一页时从mysql补齐更早的消息
+	if len(messages) < limit {
+		cursor := lastChatId
+		if len(messages) > 0 {
+			cursor = messages[len(messages)-1].ChatId
+		}
+		older, err := mysql.LoadGroupMessage(req.CommunityId, cursor, limit-len(messages))
+		if err != nil {
+			logger.Error("mysql load group message error",
+				zap.Error(err),
+				zap.Int64("communityId", req.CommunityId),
+				zap.Int64("lastChatId", cursor))
+			logging.SetSpanError(span, err)
+			return str.ErrMessageError
+		}
+		fillGroupSenders(ctx, older, logger)
+		messages = append(messages, older...)
+	}
+	if len(messages) == limit {
+		resp.NextChatId = messages[len(messages)-1].ChatId
+	}
+	if req.LastChatId <= 0 && len(messages) > 0 {
+		if err := redis.SetGroupRead(ctx, req.UserId, req.CommunityId, messages[0].ChatId); err != nil {
+			logger.Error("redis set group read error",
+				zap.Error(err),
+				zap.Int64("userId", req.UserId),
+				zap.Int64("communityId", req.CommunityId))
+		}
+	}
+	resp.GroupMessages = make([]*messagePb.GroupMessage, len(messages))
+	for i, message := range messages {
+		resp.GroupMessages[i] = convertGroupMessageToPB(message)
+	}
+	return nil
+}
+
+// fillGroupSenders mysql中不保存发送者信息，加载后补充发送者的用户名和头像
+func fillGroupSenders(ctx context.Context, messages []*models.GroupMessage, logger *zap.Logger) {
+	senders := make(map[int64]*userPb.User)
+	for _, message := range messages {
+		sender, ok := senders[message.SdUserId]
+		if !ok {
+			senderResp, err := userService.GetUserInfo(ctx, &userPb.GetUserInfoRequest{
+				UserId: message.SdUserId,
+			})
+			if err != nil {
+				logger.Error("get sender user info error",
+					zap.Error(err),
+					zap.Int64("senderId", message.SdUserId))
+			} else {
+				sender = senderResp.User
+			}
+			senders[message.SdUserId] = sender
+		}
+		if sender != nil {
+			message.UserName = sender.Username
+			message.Img = sender.GetAvatar()
+		}
+	}
+}
+
+func convertGroupMessageToPB(message *models.GroupMessage) *messagePb.GroupMessage {
+	return &messagePb.GroupMessage{
+		ChatId:      message.ChatId,
+		CommunityId: message.CommunityId,
+		SenderId:    message.SdUserId,
+		SenderName:  message.UserName,
+		SenderImg:   message.Img,
+		Content:     message.Content,
+		SendTime:    message.SendTime.Format(str.ParseTimeFormat),
+	}
+}
+
+// GetGroupUnreadCount 获取用户关注的各社区群聊的未读消息数
+func (m *MessageSrv) GetGroupUnreadCount(ctx context.Context, req *messagePb.GetGroupUnreadCountRequest, resp *messagePb.GetGroupUnreadCountResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "GetGroupUnreadCountService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "MessageService.GetGroupUnreadCount")
+
+	listResp, err := communityService.GetFollowCommunityList(ctx, &communityPb.GetFollowCommunityListRequest{
+		UserId: req.UserId,
+	})
+	if err != nil {
+		logger.Error("community service error",
+			zap.Error(err),
+			zap.Int64("userId", req.UserId))
+		logging.SetSpanError(span, err)
+		return str.ErrMessageError
+	}
+	reads, err := redis.GetGroupRead(ctx, req.UserId)
+	if err != nil {
+		logger.Error("redis get group read error",
+			zap.Error(err),
+			zap.Int64("userId", req.UserId))
+		logging.SetSpanError(span, err)
+		return str.ErrMessageError
+	}
+	resp.UnreadCounts = make(map[int64]int64, len(listResp.CommunityList))
+	for _, community := range listResp.CommunityList {
+		count, err := redis.CountGroupUnread(ctx, community.CommunityId, reads[community.CommunityId])
+		if err != nil {
+			logger.Error("redis count group unread error",
+				zap.Error(err),
+				zap.Int64("userId", req.UserId),
+				zap.Int64("communityId", community.CommunityId))
+			logging.SetSpanError(span, err)
+			return str.ErrMessageError
+		}
+		resp.UnreadCounts[community.CommunityId] = count
+	}
+	return nil
+}
+
+// MarkGroupRead 将群聊已读位置更新到指定消息
+func (m *MessageSrv) MarkGroupRead(ctx context.Context, req *messagePb.MarkGroupReadRequest, resp *messagePb.MarkGroupReadResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "MarkGroupReadService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "MessageService.MarkGroupRead")
+
+	if req.ChatId <= 0 {
+		return str.ErrInvalidParam
+	}
+	if err := checkCommunityMember(ctx, req.UserId, req.CommunityId, logger); err != nil {
+		logging.SetSpanError(span, err)
+		return err
+	}
+	if err := redis.SetGroupRead(ctx, req.UserId, req.CommunityId, req.ChatId); err != nil {
+		logger.Error("redis set group read error",
+			zap.Error(err),
+			zap.Int64("userId", req.UserId),
+			zap.Int64("communityId", req.CommunityId),
+			zap.Int64("chatId", req.ChatId))
+		logging.SetSpanError(span, err)
+		return str.ErrMessageError
+	}
+	return nil
+}
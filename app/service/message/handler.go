@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"star/app/constant/str"
+	"star/app/extra/tracing"
+	"star/app/storage/redis"
+	"star/app/utils/logging"
+	"time"
+)
+
+// 同一连接发送输入状态的最小间隔
+const typingInterval = time.Second
+
+// ackData 发送结果确认，客户端通过clientMsgId匹配自己发送的消息
+type ackData struct {
+	ClientMsgId string `json:"clientMsgId"`
+	MessageId   int64  `json:"messageId,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// typingData 对方正在输入
+type typingData struct {
+	UserId int64 `json:"userId"`
+}
+
+// readData 对方已读到messageId，为0时表示全部已读
+type readData struct {
+	UserId    int64 `json:"userId"`
+	MessageId int64 `json:"messageId"`
+}
+
+// handle 处理客户端上行的消息帧
+func (c *Client) handle(frame *inboundFrame) {
+	var err error
+	switch frame.Type {
+	case actionSendPrivate:
+		messageId, err := sendPrivate(c, frame)
+		c.ack(frame.ClientMsgId, messageId, err)
+		return
+	case actionSendGroup:
+		messageId, err := sendGroup(c, frame)
+		c.ack(frame.ClientMsgId, messageId, err)
+		return
+	case actionTyping:
+		err = sendTyping(c, frame)
+	case actionRead:
+		err = readPrivate(c, frame)
+	case actionJoinGroup:
+		err = joinGroup(c, frame.CommunityId)
+	case actionLeaveGroup:
+		manager.Leave(c, frame.CommunityId)
+	default:
+		manager.SendToClient(c, &Frame{Type: frameError, Data: "unknown frame type: " + frame.Type})
+		return
+	}
+	if err != nil {
+		manager.SendToClient(c, &Frame{Type: frameError, Data: err.Error()})
+	}
+}
+
+// ack 向客户端确认消息的发送结果
+func (c *Client) ack(clientMsgId string, messageId int64, err error) {
+	data := &ackData{
+		ClientMsgId: clientMsgId,
+		MessageId:   messageId,
+	}
+	if err != nil {
+		data.Error = err.Error()
+	}
+	manager.SendToClient(c, &Frame{Type: frameAck, Data: data})
+}
+
+// dedupeSend 客户端未收到ack重发同一clientMsgId时，直接返回已发送的消息id
+func dedupeSend(ctx context.Context, userId int64, clientMsgId string, logger *zap.Logger, send func() (int64, error)) (int64, error) {
+	if clientMsgId == str.Empty {
+		return send()
+	}
+	messageId, err := redis.GetClientMsg(ctx, userId, clientMsgId)
+	if err != nil {
+		//去重失败时仍然发送，由客户端按消息id去重
+		logger.Error("redis get client msg error",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.String("clientMsgId", clientMsgId))
+	} else if messageId != 0 {
+		return messageId, nil
+	}
+	messageId, err = send()
+	if err != nil {
+		return 0, err
+	}
+	if err := redis.SaveClientMsg(ctx, userId, clientMsgId, messageId); err != nil {
+		logger.Error("redis save client msg error",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.String("clientMsgId", clientMsgId))
+	}
+	return messageId, nil
+}
+
+// sendPrivate 处理websocket上行的私信，返回消息id
+func sendPrivate(client *Client, frame *inboundFrame) (int64, error) {
+	ctx, span := tracing.Tracer.Start(context.Background(), "SendPrivateService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "MessageService.SendPrivate")
+
+	return dedupeSend(ctx, client.userId, frame.ClientMsgId, logger, func() (int64, error) {
+		message, err := sendPrivateMessage(ctx, client.userId, frame.RecipientId, frame.PrivateChatId, frame.Content, span, logger)
+		if err != nil {
+			return 0, err
+		}
+		return message.Id, nil
+	})
+}
+
+// sendTyping 将输入状态转发给对方，不做持久化
+func sendTyping(client *Client, frame *inboundFrame) error {
+	if frame.RecipientId <= 0 || frame.RecipientId == client.userId {
+		return str.ErrInvalidParam
+	}
+	now := time.Now()
+	if now.Sub(client.lastTyping) < typingInterval {
+		return nil
+	}
+	client.lastTyping = now
+
+	ctx, span := tracing.Tracer.Start(context.Background(), "SendTypingService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "MessageService.SendTyping")
+
+	if _, err := deliver(ctx, frame.RecipientId, &Frame{Type: frameTyping, Data: &typingData{UserId: client.userId}}); err != nil {
+		logger.Error("deliver typing error",
+			zap.Error(err),
+			zap.Int64("senderId", client.userId),
+			zap.Int64("recipientId", frame.RecipientId))
+		logging.SetSpanError(span, err)
+		return str.ErrMessageError
+	}
+	return nil
+}
+
+// readPrivate 将对方发来的私信标记为已读到messageId
+func readPrivate(client *Client, frame *inboundFrame) error {
+	if frame.MessageId <= 0 {
+		return str.ErrInvalidParam
+	}
+	ctx, span := tracing.Tracer.Start(context.Background(), "ReadPrivateService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "MessageService.ReadPrivate")
+
+	return markChatRead(ctx, client.userId, frame.RecipientId, frame.MessageId, span, logger)
+}
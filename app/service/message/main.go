@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"github.com/gin-gonic/gin"
 	"github.com/go-micro/plugins/v4/registry/etcd"
 	"go-micro.dev/v4"
 	"go-micro.dev/v4/registry"
@@ -10,6 +11,7 @@ import (
 	"star/app/constant/settings"
 	"star/app/constant/str"
 	"star/app/extra/tracing"
+	"star/app/gateway/middleware"
 	"star/app/utils/logging"
 	"star/app/utils/snowflake"
 	"star/proto/message/messagePb"
@@ -35,6 +37,16 @@ func main() {
 		}
 	}()
 	messageSrvIns.New()
+	//启动websocket服务
+	go func() {
+		r := gin.New()
+		r.GET("/ws", middleware.JWTAuthHandler, Run)
+		if err := r.Run(settings.Conf.GetWsAddr()); err != nil {
+			logging.Logger.Error("websocket server run error",
+				zap.Error(err))
+			panic(err)
+		}
+	}()
 	//etcd注册件
 	etcdReg := etcd.NewRegistry(
 		registry.Addrs(fmt.Sprintf("%s:%d", settings.Conf.EtcdHost, settings.Conf.EtcdPort)),
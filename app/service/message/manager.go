@@ -2,75 +2,156 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"go.uber.org/zap"
-	"star/app/constant/str"
 	"star/app/storage/redis"
 	"star/app/utils/logging"
 	"sync"
 )
 
 type ClientManager struct {
-	ClientMap  map[int64]*Client
+	ClientMap  map[int64]map[*Client]struct{} //用户id->在本实例的所有连接，同一用户可以多端同时在线
+	Rooms      map[int64]map[*Client]struct{} //社区id->在本实例加入该社区群聊的连接
 	Lock       sync.RWMutex
-	Connect    chan *Client
-	Disconnect chan *Client
+	Disconnect chan *Client //读写协程退出时发送，连接在开始读写前已同步注册
 }
 
 func NewManager() *ClientManager {
 	return &ClientManager{
-		ClientMap:  make(map[int64]*Client),
-		Connect:    make(chan *Client, 10000),
+		ClientMap:  make(map[int64]map[*Client]struct{}),
+		Rooms:      make(map[int64]map[*Client]struct{}),
 		Disconnect: make(chan *Client, 10000),
 	}
 }
 
 func (m *ClientManager) Run() {
-	for {
-		select {
-		case client := <-m.Connect:
-			m.EventConnect(client)
-		case client := <-m.Disconnect:
-			m.EventDisConnect(client)
-		}
+	for client := range m.Disconnect {
+		m.EventDisConnect(client)
 	}
 }
 
-// SaveServiceId 将websocket连接的serviceId储存到redis中
-func SaveServiceId(userId int64, instanceId string) error {
-	//将ServiceId保存到redis中去
-	key := fmt.Sprintf("websocket:%d", userId)
-	serviceId := "messageService:" + instanceId
-	err := redis.Client.SetNX(context.Background(), key, serviceId, 0).Err()
-	if err != nil {
-		logging.Logger.Error("redis.SetNX serviceId failed", zap.Error(err))
-		return str.ErrServiceBusy
-	}
-	return nil
+// SaveServiceId 将websocket连接所在的实例储存到redis中，其他实例据此投递私信
+func SaveServiceId(client *Client) error {
+	return redis.SaveWsConn(context.Background(), client.userId, client.connId, instanceId)
 }
 
 func (m *ClientManager) EventConnect(client *Client) {
 	m.Lock.Lock()
 	defer m.Lock.Unlock()
 
-	m.ClientMap[client.userId] = client
+	clients, ok := m.ClientMap[client.userId]
+	if !ok {
+		clients = make(map[*Client]struct{})
+		m.ClientMap[client.userId] = clients
+	}
+	clients[client] = struct{}{}
 }
 
 func (m *ClientManager) EventDisConnect(client *Client) {
+	m.removeClient(client)
+	if err := redis.RemoveWsConn(context.Background(), client.userId, client.connId); err != nil {
+		logging.Logger.Error("redis remove websocket conn error",
+			zap.Error(err),
+			zap.Int64("userId", client.userId),
+			zap.String("connId", client.connId))
+	}
+}
+
+func (m *ClientManager) removeClient(client *Client) {
 	m.Lock.Lock()
 	defer m.Lock.Unlock()
 
 	_ = client.conn.Close()
 	close(client.send)
-	delete(m.ClientMap, client.userId)
+	for communityId := range client.rooms {
+		m.leave(client, communityId)
+	}
+	clients := m.ClientMap[client.userId]
+	delete(clients, client)
+	if len(clients) == 0 {
+		delete(m.ClientMap, client.userId)
+	}
+}
+
+// Join 将连接加入社区群聊
+func (m *ClientManager) Join(client *Client, communityId int64) {
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+
+	room, ok := m.Rooms[communityId]
+	if !ok {
+		room = make(map[*Client]struct{})
+		m.Rooms[communityId] = room
+	}
+	room[client] = struct{}{}
+	client.rooms[communityId] = struct{}{}
+}
+
+// Leave 将连接移出社区群聊
+func (m *ClientManager) Leave(client *Client, communityId int64) {
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+
+	m.leave(client, communityId)
+}
+
+func (m *ClientManager) leave(client *Client, communityId int64) {
+	delete(client.rooms, communityId)
+	room, ok := m.Rooms[communityId]
+	if !ok {
+		return
+	}
+	delete(room, client)
+	if len(room) == 0 {
+		delete(m.Rooms, communityId)
+	}
+}
+
+// SendTo 向用户在本实例上的所有连接推送消息帧，用户不在本实例时返回false
+func (m *ClientManager) SendTo(userId int64, frame *Frame) bool {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+
+	clients, ok := m.ClientMap[userId]
+	if !ok {
+		return false
+	}
+	for client := range clients {
+		m.push(client, frame)
+	}
+	return true
 }
 
-func (m *ClientManager) GetClient(userId int64) (*Client, bool) {
+// SendToClient 向指定连接推送消息帧
+func (m *ClientManager) SendToClient(client *Client, frame *Frame) bool {
 	m.Lock.RLock()
 	defer m.Lock.RUnlock()
 
-	if client, ok := m.ClientMap[userId]; ok {
-		return client, true
+	//连接已断开时send通道已关闭，不能再写入
+	if _, ok := m.ClientMap[client.userId][client]; !ok {
+		return false
+	}
+	return m.push(client, frame)
+}
+
+// Broadcast 向本实例上加入社区群聊的所有连接推送消息帧
+func (m *ClientManager) Broadcast(communityId int64, frame *Frame) {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+
+	for client := range m.Rooms[communityId] {
+		m.push(client, frame)
+	}
+}
+
+// push 需在持有锁时调用，发送缓冲区满时丢弃消息，避免慢连接阻塞其他连接
+func (m *ClientManager) push(client *Client, frame *Frame) bool {
+	select {
+	case client.send <- frame:
+		return true
+	default:
+		logging.Logger.Warn("client send buffer is full",
+			zap.Int64("userId", client.userId),
+			zap.String("type", frame.Type))
+		return false
 	}
-	return nil, false
 }
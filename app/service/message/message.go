@@ -15,24 +15,30 @@ import (
 	"star/app/constant/str"
 	"star/app/extra/tracing"
 	"star/app/models"
-	"star/app/storage/cached"
 	"star/app/storage/mysql"
 	"star/app/storage/redis"
 	"star/app/utils/logging"
 	"star/app/utils/rabbitmq"
 	"star/app/utils/snowflake"
+	"star/proto/community/communityPb"
 	"star/proto/message/messagePb"
 	"star/proto/user/userPb"
+	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 )
 
-const sendPrivateMsgMaxQPS = 3
+const (
+	sendPrivateMsgMaxQPS    = 3
+	maxPrivateMessageLength = 255 //与private_messages.content长度一致
+)
 
 type MessageSrv struct {
 }
 
 var userService userPb.UserService
+var communityService communityPb.CommunityService
 var conn *amqp091.Connection
 var channel *amqp091.Channel
 var manager = NewManager()
@@ -92,6 +98,10 @@ func (m *MessageSrv) New() {
 		false, false, false, false,
 		nil)
 	failOnError(err, "message service failed to declare a mention queue")
+	_, err = channel.QueueDeclare(str.MessageGroupMsg,
+		false, false, false, false,
+		nil)
+	failOnError(err, "message service failed to declare a group_msg queue")
 
 	//绑定队列
 	// 绑定点赞消息
@@ -103,7 +113,7 @@ func (m *MessageSrv) New() {
 	failOnError(err, "message service failed to bind mention queue")
 
 	// 绑定回复消息
-	err = channel.QueueBind(str.MessageReply, str.RoutMention, str.MessageExchange, false, nil)
+	err = channel.QueueBind(str.MessageReply, str.RoutReply, str.MessageExchange, false, nil)
 	failOnError(err, "message service failed to bind reply queue")
 
 	// 绑定系统通知
@@ -114,16 +124,31 @@ func (m *MessageSrv) New() {
 	err = channel.QueueBind(str.MessagePrivateMsg, str.RoutPrivateMsg, str.MessageExchange, false, nil)
 	failOnError(err, "message service failed to bind private message queue")
 
+	// 绑定群聊消息
+	err = channel.QueueBind(str.MessageGroupMsg, str.RoutGroupMsg, str.MessageExchange, false, nil)
+	failOnError(err, "message service failed to bind group message queue")
+
 	//创建一个用户微服务客户端
 	userMicroService := micro.NewService(micro.Name(str.UserServiceClient))
 	userService = userPb.NewUserService(str.UserService, userMicroService.Client())
+	//创建一个社区微服务客户端
+	communityMicroService := micro.NewService(micro.Name(str.CommunityServiceClient))
+	communityService = communityPb.NewCommunityService(str.CommunityService, communityMicroService.Client())
 
 	cronRunner := cron.New()
 	cronRunner.AddFunc("0 2 * * * ?", removeMessage)
 
 	cronRunner.Start()
 
+	instanceId, err = getInstanceId()
+	if err != nil {
+		logging.Logger.Error("message service failed to get instance id",
+			zap.Error(err))
+		panic(err)
+	}
 	go manager.Run()
+	go subscribeGroupMessage()
+	go subscribeInstance()
 
 }
 
@@ -134,44 +159,30 @@ func (m *MessageSrv) ListMessageCount(ctx context.Context, req *messagePb.ListMe
 	logging.SetSpanWithHostname(span)
 	logger := logging.LogServiceWithTrace(span, "MessageService.ListMessageCount")
 
-	//获取countsStr
-	key := fmt.Sprintf("ListMessageCount:+%d", req.UserId)
-	countsStr, err := cached.GetWithFunc(ctx, key, func(key string) (string, error) {
-		counts, err := mysql.ListMessageCount(req.UserId)
+	pullSystemNotice(ctx, req.UserId, logger)
+	counts, ok, err := redis.GetMessageCount(ctx, req.UserId)
+	if err != nil {
+		//缓存出错时直接从mysql统计
+		logger.Error("redis get message count error",
+			zap.Error(err),
+			zap.Int64("userId", req.UserId))
+	}
+	if !ok {
+		counts, err = mysql.ListMessageCount(req.UserId)
 		if err != nil {
 			logger.Error("ListMessageCount service error",
-				zap.Error(err))
+				zap.Error(err),
+				zap.Int64("userId", req.UserId))
 			logging.SetSpanError(span, err)
-			return "", str.ErrMessageError
+			return str.ErrMessageError
 		}
-		countsJson, err := json.Marshal(counts)
-		if err != nil {
-			logger.Error("json marshal counts error:",
+		if err := redis.SetMessageCount(ctx, req.UserId, counts); err != nil {
+			logger.Error("redis set message count error",
 				zap.Error(err),
-				zap.Any("counts", counts))
-			logging.SetSpanError(span, err)
-			return "", err
+				zap.Int64("userId", req.UserId))
 		}
-		return string(countsJson), nil
-
-	})
-	if err != nil {
-		logger.Error("ListMessageCount service error",
-			zap.Error(err),
-			zap.Int64("userId", req.UserId))
-		logging.SetSpanError(span, err)
-		return err
-	}
-	//解析countsStr
-	counts := new(models.Counts)
-	err = json.Unmarshal([]byte(countsStr), counts)
-	if err != nil {
-		logger.Error("json unmarshal counts error",
-			zap.Error(err),
-			zap.Int64("userId", req.UserId))
-		logging.SetSpanError(span, err)
-		return str.ErrMessageError
 	}
+	counts.TotalCount = counts.MentionCount + counts.LikeCount + counts.SystemCount + counts.ReplyCount + counts.PrivateMsgCount
 	resp.Count = &messagePb.Counts{
 		PrivateMsgCount: counts.PrivateMsgCount,
 		MentionCount:    counts.MentionCount,
@@ -190,15 +201,24 @@ func (m *MessageSrv) SendSystemMessage(ctx context.Context, req *messagePb.SendS
 	logging.SetSpanWithHostname(span)
 	logger := logging.LogServiceWithTrace(span, "MessageService.SendSystemMessage")
 
+	if err := checkNotice(req); err != nil {
+		return err
+	}
+	publishTime := time.Now().UTC()
+	if req.PublishAt > publishTime.Unix() {
+		publishTime = time.Unix(req.PublishAt, 0).UTC()
+	}
 	message := &models.SystemMessage{
 		Id:          snowflake.GetID(),
 		RecipientId: req.RecipientId,
+		TargetId:    req.CommunityId,
+		Recipients:  req.RecipientIds,
 		ManagerId:   req.ManagerId,
 		Type:        req.Type,
 		Title:       req.Title,
 		Content:     req.Content,
 		Status:      false,
-		PublishTime: time.Now().UTC(),
+		PublishTime: publishTime,
 	}
 	body, err := json.Marshal(message)
 	if err != nil {
@@ -228,6 +248,7 @@ func (m *MessageSrv) SendSystemMessage(ctx context.Context, req *messagePb.SendS
 		logging.SetSpanError(span, err)
 		return str.ErrMessageError
 	}
+	resp.SystemMessageId = message.Id
 	return nil
 }
 func sendPrivateMessageLimitKey(userId int64) string {
@@ -241,68 +262,88 @@ func (m *MessageSrv) SendPrivateMessage(ctx context.Context, req *messagePb.Send
 	logging.SetSpanWithHostname(span)
 	logger := logging.LogServiceWithTrace(span, "MessageService.SendPrivateMessage")
 
+	_, err := sendPrivateMessage(ctx, req.SenderId, req.RecipientId, req.PrivateChatId, req.Content, span, logger)
+	return err
+}
+
+// sendPrivateMessage 限流校验后保存私信，推送给双方在线的连接并异步持久化
+func sendPrivateMessage(ctx context.Context, senderId int64, recipientId int64, privateChatId int64, content string, span trace.Span, logger *zap.Logger) (*models.PrivateMessage, error) {
+	if strings.TrimSpace(content) == str.Empty || utf8.RuneCountInString(content) > maxPrivateMessageLength {
+		return nil, str.ErrInvalidMessage
+	}
+	if recipientId == senderId {
+		return nil, str.ErrInvalidParam
+	}
 	limiter := redis_rate.NewLimiter(redis.Client)
-	limiterKey := sendPrivateMessageLimitKey(req.SenderId)
+	limiterKey := sendPrivateMessageLimitKey(senderId)
 	limitRes, err := limiter.Allow(ctx, limiterKey, redis_rate.PerSecond(sendPrivateMsgMaxQPS))
 	if err != nil {
 		logger.Error("sendPrivateMsg limit error",
 			zap.Error(err),
-			zap.Int64("senderId", req.SenderId),
-			zap.Int64("recipientId", req.RecipientId),
-			zap.Int64("privateChatId", req.PrivateChatId),
-			zap.String("content", req.Content))
+			zap.Int64("senderId", senderId),
+			zap.Int64("recipientId", recipientId),
+			zap.Int64("privateChatId", privateChatId),
+			zap.String("content", content))
 		logging.SetSpanError(span, err)
-		return str.ErrMessageError
+		return nil, str.ErrMessageError
 	}
 	if limitRes.Allowed == 0 {
 		logger.Error("user send private msg too frequently",
 			zap.Error(err),
-			zap.Int64("senderId", req.SenderId),
-			zap.Int64("recipientId", req.RecipientId),
-			zap.Int64("privateChatId", req.PrivateChatId),
-			zap.String("content", req.Content))
+			zap.Int64("senderId", senderId),
+			zap.Int64("recipientId", recipientId),
+			zap.Int64("privateChatId", privateChatId),
+			zap.String("content", content))
 		logging.SetSpanError(span, err)
-		return str.ErrRequestTooFrequently
+		return nil, str.ErrRequestTooFrequently
 	}
 
 	userResp, err := userService.GetUserExistInformation(ctx, &userPb.GetUserExistInformationRequest{
-		UserId: req.RecipientId,
+		UserId: recipientId,
 	})
 	if err != nil {
 		logger.Error("user service error",
 			zap.Error(err),
-			zap.Int64("userId", req.RecipientId),
-			zap.String("content", req.Content))
+			zap.Int64("userId", recipientId),
+			zap.String("content", content))
 		logging.SetSpanError(span, err)
-		return str.ErrMessageError
+		return nil, str.ErrMessageError
 	}
 	if !userResp.Existed {
 		logger.Warn("recipient is not existed",
-			zap.Int64("senderId", req.SenderId),
-			zap.String("content", req.Content))
-		return str.ErrUserNotExists
+			zap.Int64("senderId", senderId),
+			zap.String("content", content))
+		return nil, str.ErrUserNotExists
 	}
 
 	message := &models.PrivateMessage{
 		Id:            snowflake.GetID(),
-		SenderId:      req.SenderId,
-		RecipientId:   req.RecipientId,
-		Content:       req.Content,
+		SenderId:      senderId,
+		RecipientId:   recipientId,
+		Content:       content,
 		Status:        false,
 		SendTime:      time.Now().UTC(),
-		PrivateChatId: req.PrivateChatId,
+		PrivateChatId: privateChatId,
 	}
 	if err := redis.SaveMessage(ctx, message); err != nil {
 		logger.Error("redis save private message error",
 			zap.Error(err),
 			zap.Any("message", message))
 		logging.SetSpanError(span, err)
-		return str.ErrMessageError
+		return nil, str.ErrMessageError
 	}
-	client, ok := manager.GetClient(req.RecipientId)
-	if ok {
-		message.Status = true
-		client.send <- message
+	//接收者在线只代表消息已送达，已读状态只由MarkChatRead修改
+	frame := &Frame{Type: framePrivate, Data: message}
+	if _, err := deliver(ctx, recipientId, frame); err != nil {
+		logger.Error("deliver private message error",
+			zap.Error(err),
+			zap.Any("message", message))
+	}
+	//同步给发送者的其他设备
+	if _, err := deliver(ctx, senderId, frame); err != nil {
+		logger.Error("deliver private message to sender error",
+			zap.Error(err),
+			zap.Any("message", message))
 	}
 	body, err := json.Marshal(message)
 	if err != nil {
@@ -310,7 +351,7 @@ func (m *MessageSrv) SendPrivateMessage(ctx context.Context, req *messagePb.Send
 			zap.Error(err),
 			zap.Any("message", message))
 		logging.SetSpanError(span, err)
-		return err
+		return nil, str.ErrMessageError
 	}
 	header := rabbitmq.InjectAMQPHeaders(ctx)
 	err = channel.PublishWithContext(
@@ -331,9 +372,9 @@ func (m *MessageSrv) SendPrivateMessage(ctx context.Context, req *messagePb.Send
 			zap.Error(err),
 			zap.Any("message", message))
 		logging.SetSpanError(span, err)
-		return err
+		return nil, str.ErrMessageError
 	}
-	return nil
+	return message, nil
 }
 
 // SendRemindMessage 发送提醒消息
@@ -378,6 +419,7 @@ func addRemindMessage(ctx context.Context, req *messagePb.SendRemindMessageReque
 		Url:         req.Url,
 		Status:      false,
 		RemindTime:  time.Now().UTC(),
+		IsDeleted:   req.IsDeleted,
 	}
 	body, err := json.Marshal(message)
 	if err != nil {
@@ -518,8 +560,8 @@ func convertChatListToPB(ctx context.Context, recipientId int64, list []*models.
 			sender := senderResp.User
 			pchat := &messagePb.PrivateChat{
 				UserId:      sender.UserId,
-				UserName:    sender.UserName,
-				Img:         *sender.Img,
+				UserName:    sender.Username,
+				Img:         sender.GetAvatar(),
 				LastMsg:     chat.LastMsgContent,
 				LastMsgTime: chat.LastSendTime.Format(str.ParseTimeFormat),
 			}
@@ -605,8 +647,8 @@ func convertMessagesToPB(messages []*models.PrivateMessage, sender *userPb.User)
 	for i, message := range messages {
 		pmessage := &messagePb.PrivateMessage{
 			SenderId:    message.SenderId,
-			SenderName:  sender.UserName,
-			SenderImg:   *sender.Img,
+			SenderName:  sender.Username,
+			SenderImg:   sender.GetAvatar(),
 			RecipientId: message.RecipientId,
 			Content:     message.Content,
 			Status:      message.Status,
@@ -658,7 +700,20 @@ func removeMessage() {
 	close(goroutineLimiter)
 }
 
+// SendMessage 发送私信并返回发送结果，与websocket上行的私信走相同的限流和持久化流程
+// 连接所在实例由redis中的连接表确定，InstanceId不再使用
 func (m *MessageSrv) SendMessage(ctx context.Context, req *messagePb.SendMessageRequest, resp *messagePb.SendMessageResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "SendMessageService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "MessageService.SendMessage")
 
+	message, err := sendPrivateMessage(ctx, req.SenderId, req.RecipientId, req.PrivateChatId, req.Content, span, logger)
+	if err != nil {
+		return err
+	}
+	resp.SenderId = message.SenderId
+	resp.RecipientId = message.RecipientId
+	resp.Content = message.Content
 	return nil
 }
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"star/app/constant/str"
+	"star/app/extra/tracing"
+	"star/app/models"
+	"star/app/storage/mysql"
+	"star/app/storage/redis"
+	"star/app/utils/logging"
+	"star/app/utils/snowflake"
+	"star/proto/community/communityPb"
+	"star/proto/message/messagePb"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	maxNoticeTitleLength   = 30  //与manager_system_notice.title长度一致
+	maxNoticeContentLength = 255 //与manager_system_notice.content长度一致
+	maxNoticeRecipients    = 1000
+	// 拉取区间与上次拉取重叠，避免拉取时正在写入的通知被跳过，重复的通知写入时会被忽略
+	noticePullOverlap = time.Minute
+)
+
+// checkNotice 校验系统通知的内容和发送对象
+func checkNotice(req *messagePb.SendSystemMessageRequest) error {
+	title := strings.TrimSpace(req.Title)
+	content := strings.TrimSpace(req.Content)
+	if title == "" || content == "" ||
+		utf8.RuneCountInString(req.Title) > maxNoticeTitleLength ||
+		utf8.RuneCountInString(req.Content) > maxNoticeContentLength {
+		return str.ErrInvalidNotice
+	}
+	switch req.Type {
+	case models.NoticeSingle:
+		if req.RecipientId <= 0 {
+			return str.ErrInvalidNotice
+		}
+	case models.NoticeAll:
+	case models.NoticeCommunity:
+		if req.CommunityId <= 0 {
+			return str.ErrInvalidNotice
+		}
+	case models.NoticeUsers:
+		if len(req.RecipientIds) == 0 || len(req.RecipientIds) > maxNoticeRecipients {
+			return str.ErrInvalidNotice
+		}
+	default:
+		return str.ErrInvalidNotice
+	}
+	return nil
+}
+
+// pullSystemNotice 将上次拉取之后写入或到达发布时间的、发送给该用户的系统通知写入用户的通知列表，
+// 按写入时间拉取，消费者延迟写入的通知不会因为发布时间早于上次拉取而被跳过
+// 拉取失败时只记录日志，下次拉取会重新获取
+func pullSystemNotice(ctx context.Context, userId int64, logger *zap.Logger) {
+	now := time.Now().UTC()
+	since, ok, err := redis.GetNoticePullTime(ctx, userId)
+	if err != nil {
+		logger.Error("redis get notice pull time error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		return
+	}
+	if ok {
+		since = since.Add(-noticePullOverlap)
+	} else {
+		since = now.Add(-redis.NoticePullWindow)
+	}
+	notices, err := mysql.ListPendingNotice(userId, since, now)
+	if err != nil {
+		logger.Error("mysql list pending notice error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		return
+	}
+	notices, err = filterNotice(ctx, userId, notices)
+	if err != nil {
+		logger.Error("filter system notice error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		return
+	}
+	userNotices := make([]*models.SystemMessageUser, len(notices))
+	for i, notice := range notices {
+		userNotices[i] = &models.SystemMessageUser{
+			Id:              snowflake.GetID(),
+			SystemMessageId: notice.Id,
+			RecipientId:     userId,
+			Status:          false,
+			PullTime:        now,
+		}
+	}
+	count, err := mysql.InsertUserNotice(userNotices)
+	if err != nil {
+		logger.Error("mysql insert user notice error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		return
+	}
+	if err := redis.SetNoticePullTime(ctx, userId, now); err != nil {
+		logger.Error("redis set notice pull time error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+	}
+	if count > 0 {
+		if err := redis.IncrMessageCount(ctx, userId, redis.CountSystem, count); err != nil {
+			logger.Error("redis incr message count error",
+				zap.Error(err),
+				zap.Int64("userId", userId))
+		}
+	}
+}
+
+// filterNotice 过滤出发送给该用户的通知，社区通知只发送给社区的关注者
+func filterNotice(ctx context.Context, userId int64, notices []*models.SystemMessage) ([]*models.SystemMessage, error) {
+	var followed map[int64]struct{}
+	var userNoticeIds []int64
+	for _, notice := range notices {
+		switch notice.Type {
+		case models.NoticeCommunity:
+			if followed != nil {
+				continue
+			}
+			listResp, err := communityService.GetFollowCommunityList(ctx, &communityPb.GetFollowCommunityListRequest{
+				UserId: userId,
+			})
+			if err != nil {
+				return nil, err
+			}
+			followed = make(map[int64]struct{}, len(listResp.CommunityList))
+			for _, community := range listResp.CommunityList {
+				followed[community.CommunityId] = struct{}{}
+			}
+		case models.NoticeUsers:
+			userNoticeIds = append(userNoticeIds, notice.Id)
+		}
+	}
+	ids, err := mysql.FilterNoticeRecipient(userId, userNoticeIds)
+	if err != nil {
+		return nil, err
+	}
+	received := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		received[id] = struct{}{}
+	}
+	matched := notices[:0]
+	for _, notice := range notices {
+		var ok bool
+		switch notice.Type {
+		case models.NoticeSingle:
+			ok = notice.RecipientId == userId
+		case models.NoticeAll:
+			ok = true
+		case models.NoticeCommunity:
+			_, ok = followed[notice.TargetId]
+		case models.NoticeUsers:
+			_, ok = received[notice.Id]
+		}
+		if ok {
+			matched = append(matched, notice)
+		}
+	}
+	return matched, nil
+}
+
+// RevokeSystemMessage 撤回系统通知，已拉取的用户也不再展示
+func (m *MessageSrv) RevokeSystemMessage(ctx context.Context, req *messagePb.RevokeSystemMessageRequest, resp *messagePb.RevokeSystemMessageResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "RevokeSystemMessageService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "MessageService.RevokeSystemMessage")
+
+	recipients, err := mysql.RevokeSystemNotice(req.SystemMessageId)
+	if err != nil {
+		if errors.Is(err, str.ErrNoticeNotExists) {
+			return err
+		}
+		logger.Error("mysql revoke system notice error",
+			zap.Error(err),
+			zap.Int64("managerId", req.ManagerId),
+			zap.Int64("systemMessageId", req.SystemMessageId))
+		logging.SetSpanError(span, err)
+		return str.ErrMessageError
+	}
+	if err := redis.DelMessageCounts(ctx, recipients); err != nil {
+		logger.Error("redis delete message counts error",
+			zap.Error(err),
+			zap.Int64("systemMessageId", req.SystemMessageId))
+	}
+	logger.Info("system message revoked",
+		zap.Int64("managerId", req.ManagerId),
+		zap.Int64("systemMessageId", req.SystemMessageId))
+	return nil
+}
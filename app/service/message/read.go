@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"math"
+	"star/app/constant/str"
+	"star/app/extra/tracing"
+	"star/app/storage/mysql"
+	"star/app/storage/redis"
+	"star/app/utils/logging"
+	"star/proto/message/messagePb"
+)
+
+// MarkChatRead 将与某用户会话中对方发来的私信标记为已读
+func (m *MessageSrv) MarkChatRead(ctx context.Context, req *messagePb.MarkChatReadRequest, resp *messagePb.MarkChatReadResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "MarkChatReadService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "MessageService.MarkChatRead")
+
+	return markChatRead(ctx, req.UserId, req.SenderId, req.LastMessageId, span, logger)
+}
+
+// markChatRead 标记私信已读后删除未读数缓存，并将已读回执推送给对方和自己的其他设备
+// lastMessageId为0时将会话中的私信全部标记为已读
+func markChatRead(ctx context.Context, userId int64, senderId int64, lastMessageId int64, span trace.Span, logger *zap.Logger) error {
+	if senderId <= 0 || senderId == userId || lastMessageId < 0 {
+		return str.ErrInvalidParam
+	}
+	readTo := lastMessageId
+	if readTo == 0 {
+		readTo = math.MaxInt64
+	}
+	count, err := mysql.ReadPrivateMessage(userId, senderId, readTo)
+	if err != nil {
+		logger.Error("mysql read private message error",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.Int64("senderId", senderId),
+			zap.Int64("lastMessageId", lastMessageId))
+		logging.SetSpanError(span, err)
+		return str.ErrMessageError
+	}
+	if count > 0 {
+		invalidateMessageCount(ctx, userId, logger)
+	}
+	read := &Frame{Type: frameRead, Data: &readData{UserId: userId, MessageId: lastMessageId}}
+	for _, id := range []int64{senderId, userId} {
+		if _, err := deliver(ctx, id, read); err != nil {
+			logger.Error("deliver read receipt error",
+				zap.Error(err),
+				zap.Int64("userId", id),
+				zap.Int64("lastMessageId", lastMessageId))
+		}
+	}
+	return nil
+}
+
+// MarkRemindRead 将某一类型的提醒全部标记为已读
+func (m *MessageSrv) MarkRemindRead(ctx context.Context, req *messagePb.MarkRemindReadRequest, resp *messagePb.MarkRemindReadResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "MarkRemindReadService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "MessageService.MarkRemindRead")
+
+	if err := mysql.ReadRemindMessage(req.UserId, req.Type); err != nil {
+		if errors.Is(err, str.ErrInvalidParam) {
+			return err
+		}
+		logger.Error("mysql read remind message error",
+			zap.Error(err),
+			zap.Int64("userId", req.UserId),
+			zap.String("type", req.Type))
+		logging.SetSpanError(span, err)
+		return str.ErrMessageError
+	}
+	invalidateMessageCount(ctx, req.UserId, logger)
+	return nil
+}
+
+// MarkAllRead 将私信和所有提醒标记为已读
+func (m *MessageSrv) MarkAllRead(ctx context.Context, req *messagePb.MarkAllReadRequest, resp *messagePb.MarkAllReadResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "MarkAllReadService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "MessageService.MarkAllRead")
+
+	if err := mysql.ReadAllMessage(req.UserId); err != nil {
+		logger.Error("mysql read all message error",
+			zap.Error(err),
+			zap.Int64("userId", req.UserId))
+		logging.SetSpanError(span, err)
+		return str.ErrMessageError
+	}
+	invalidateMessageCount(ctx, req.UserId, logger)
+	return nil
+}
+
+// invalidateMessageCount 删除未读数缓存，下次获取时从mysql重新统计
+func invalidateMessageCount(ctx context.Context, userId int64, logger *zap.Logger) {
+	if err := redis.DelMessageCount(ctx, userId); err != nil {
+		logger.Error("redis delete message count error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+	}
+}
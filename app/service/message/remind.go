@@ -0,0 +1,292 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"go.uber.org/zap"
+	"math"
+	"star/app/constant/str"
+	"star/app/extra/tracing"
+	"star/app/models"
+	"star/app/storage/mysql"
+	"star/app/storage/redis"
+	"star/app/utils/logging"
+	"star/app/utils/mention"
+	"star/proto/message/messagePb"
+	"star/proto/user/userPb"
+	"strconv"
+	"sync"
+)
+
+const (
+	maxListRemindNumber = 50
+	likeRemindSenders   = 3  //点赞聚合中展示的最近点赞用户数
+	previewLength       = 50 //源内容预览的长度
+)
+
+const (
+	sourceFeed    = "feed"
+	sourceComment = "comment"
+)
+
+// listParams 处理分页参数，cursor为0时从最新的一条开始
+func listParams(cursor int64, limit int64) (int64, int) {
+	if cursor <= 0 {
+		cursor = math.MaxInt64
+	}
+	if limit <= 0 {
+		limit = str.DefaultLoadMessageNumber
+	}
+	return cursor, int(min(limit, maxListRemindNumber))
+}
+
+// ListRemindMessages 分页加载点赞、回复和@提醒，点赞提醒按被点赞的内容聚合
+func (m *MessageSrv) ListRemindMessages(ctx context.Context, req *messagePb.ListRemindMessagesRequest, resp *messagePb.ListRemindMessagesResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "ListRemindMessagesService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "MessageService.ListRemindMessages")
+
+	cursor, limit := listParams(req.Cursor, req.Limit)
+	var reminds []*messagePb.RemindMessage
+	var err error
+	switch req.Type {
+	case mysql.RemindLike:
+		reminds, err = listLikeRemind(ctx, req.UserId, cursor, limit, logger)
+	case mysql.RemindMention, mysql.RemindReply:
+		reminds, err = listRemind(ctx, req.UserId, req.Type, cursor, limit, logger)
+	default:
+		return str.ErrInvalidParam
+	}
+	if err != nil {
+		logger.Error("mysql list remind message error",
+			zap.Error(err),
+			zap.Int64("userId", req.UserId),
+			zap.String("type", req.Type),
+			zap.Int64("cursor", req.Cursor))
+		logging.SetSpanError(span, err)
+		return str.ErrMessageError
+	}
+	resp.RemindMessages = reminds
+	if len(reminds) == limit {
+		resp.NextCursor = reminds[len(reminds)-1].Id
+	}
+	return nil
+}
+
+func listLikeRemind(ctx context.Context, userId int64, cursor int64, limit int, logger *zap.Logger) ([]*messagePb.RemindMessage, error) {
+	likes, err := mysql.ListLikeRemind(userId, cursor, limit, likeRemindSenders)
+	if err != nil {
+		return nil, err
+	}
+	var senderIds []int64
+	for _, like := range likes {
+		senderIds = append(senderIds, like.SenderIds...)
+	}
+	senders := getRemindSenders(ctx, senderIds, logger)
+	previews := newPreviewLoader(ctx, logger)
+	reminds := make([]*messagePb.RemindMessage, len(likes))
+	for i, like := range likes {
+		remind := convertRemindToPB(&like.RemindMessage, mysql.RemindLike)
+		remind.SenderCount = like.SenderCount
+		remind.Senders = make([]*messagePb.RemindSender, 0, len(like.SenderIds))
+		for _, id := range like.SenderIds {
+			if sender, ok := senders[id]; ok {
+				remind.Senders = append(remind.Senders, sender)
+			}
+		}
+		remind.SourcePreview = previews.load(like.SourceType, like.SourceId)
+		reminds[i] = remind
+	}
+	return reminds, nil
+}
+
+func listRemind(ctx context.Context, userId int64, remindType string, cursor int64, limit int, logger *zap.Logger) ([]*messagePb.RemindMessage, error) {
+	messages, err := mysql.ListRemindMessage(userId, remindType, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	senderIds := make([]int64, len(messages))
+	for i, message := range messages {
+		senderIds[i] = message.SenderId
+	}
+	senders := getRemindSenders(ctx, senderIds, logger)
+	previews := newPreviewLoader(ctx, logger)
+	reminds := make([]*messagePb.RemindMessage, len(messages))
+	for i, message := range messages {
+		remind := convertRemindToPB(message, remindType)
+		remind.SenderCount = 1
+		if sender, ok := senders[message.SenderId]; ok {
+			remind.Senders = []*messagePb.RemindSender{sender}
+		}
+		if remindType == mysql.RemindReply {
+			remind.SourcePreview = previews.loadReplied(message.SourceId)
+		} else {
+			remind.SourcePreview = previews.load(message.SourceType, message.SourceId)
+		}
+		reminds[i] = remind
+	}
+	return reminds, nil
+}
+
+func convertRemindToPB(message *models.RemindMessage, remindType string) *messagePb.RemindMessage {
+	return &messagePb.RemindMessage{
+		Id:         message.Id,
+		Type:       remindType,
+		SourceId:   message.SourceId,
+		SourceType: message.SourceType,
+		Content:    message.Content,
+		Url:        message.Url,
+		Status:     message.Status,
+		RemindTime: message.RemindTime.Format(str.ParseTimeFormat),
+	}
+}
+
+// getRemindSenders 并发获取提醒发送者的用户信息，获取失败的用户不返回
+func getRemindSenders(ctx context.Context, userIds []int64, logger *zap.Logger) map[int64]*messagePb.RemindSender {
+	senders := make(map[int64]*messagePb.RemindSender, len(userIds))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	seen := make(map[int64]struct{}, len(userIds))
+	for _, userId := range userIds {
+		if _, ok := seen[userId]; ok {
+			continue
+		}
+		seen[userId] = struct{}{}
+		wg.Add(1)
+		go func(userId int64) {
+			defer wg.Done()
+			userResp, err := userService.GetUserInfo(ctx, &userPb.GetUserInfoRequest{
+				UserId: userId,
+			})
+			if err != nil {
+				logger.Error("get sender user info error",
+					zap.Error(err),
+					zap.Int64("senderId", userId))
+				return
+			}
+			mu.Lock()
+			senders[userId] = &messagePb.RemindSender{
+				UserId:   userId,
+				UserName: userResp.User.Username,
+				Img:      userResp.User.GetAvatar(),
+			}
+			mu.Unlock()
+		}(userId)
+	}
+	wg.Wait()
+	return senders
+}
+
+// previewLoader 加载提醒源内容的预览，同一页中重复的源只查询一次
+type previewLoader struct {
+	ctx      context.Context
+	logger   *zap.Logger
+	previews map[string]string
+	comments map[int64]*models.Comment
+}
+
+func newPreviewLoader(ctx context.Context, logger *zap.Logger) *previewLoader {
+	return &previewLoader{
+		ctx:      ctx,
+		logger:   logger,
+		previews: make(map[string]string),
+		comments: make(map[int64]*models.Comment),
+	}
+}
+
+// load 获取帖子或评论的内容预览，源已被删除时返回空
+func (p *previewLoader) load(sourceType string, sourceId int64) string {
+	key := sourceType + ":" + strconv.FormatInt(sourceId, 10)
+	if preview, ok := p.previews[key]; ok {
+		return preview
+	}
+	var preview string
+	switch sourceType {
+	case sourceFeed:
+		post, err := redis.GetPostInfo(p.ctx, sourceId)
+		if err != nil {
+			p.logError(err, sourceType, sourceId)
+			break
+		}
+		preview = mention.Excerpt(post.Content, previewLength)
+	case sourceComment:
+		if comment := p.comment(sourceId); comment != nil {
+			preview = mention.Excerpt(comment.Content, previewLength)
+		}
+	}
+	p.previews[key] = preview
+	return preview
+}
+
+// loadReplied 获取回复所回复的评论或帖子的内容预览
+func (p *previewLoader) loadReplied(commentId int64) string {
+	reply := p.comment(commentId)
+	if reply == nil {
+		return ""
+	}
+	if reply.BeCommentId != 0 {
+		return p.load(sourceComment, reply.BeCommentId)
+	}
+	return p.load(sourceFeed, reply.PostId)
+}
+
+func (p *previewLoader) comment(commentId int64) *models.Comment {
+	if comment, ok := p.comments[commentId]; ok {
+		return comment
+	}
+	comment, err := redis.GetCommentInfo(p.ctx, commentId)
+	if err != nil {
+		p.logError(err, sourceComment, commentId)
+		comment = nil
+	}
+	p.comments[commentId] = comment
+	return comment
+}
+
+func (p *previewLoader) logError(err error, sourceType string, sourceId int64) {
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, str.ErrCommentNotExists) {
+		return
+	}
+	p.logger.Error("get remind source error",
+		zap.Error(err),
+		zap.String("sourceType", sourceType),
+		zap.Int64("sourceId", sourceId))
+}
+
+// ListSystemMessages 分页加载系统通知
+func (m *MessageSrv) ListSystemMessages(ctx context.Context, req *messagePb.ListSystemMessagesRequest, resp *messagePb.ListSystemMessagesResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "ListSystemMessagesService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "MessageService.ListSystemMessages")
+
+	if req.Cursor <= 0 {
+		pullSystemNotice(ctx, req.UserId, logger)
+	}
+	cursor, limit := listParams(req.Cursor, req.Limit)
+	notices, err := mysql.ListSystemNotice(req.UserId, cursor, limit)
+	if err != nil {
+		logger.Error("mysql list system notice error",
+			zap.Error(err),
+			zap.Int64("userId", req.UserId),
+			zap.Int64("cursor", req.Cursor))
+		logging.SetSpanError(span, err)
+		return str.ErrMessageError
+	}
+	resp.SystemMessages = make([]*messagePb.SystemMessage, len(notices))
+	for i, notice := range notices {
+		resp.SystemMessages[i] = &messagePb.SystemMessage{
+			Id:          notice.Id,
+			Title:       notice.Title,
+			Content:     notice.Content,
+			Status:      notice.Status,
+			PublishTime: notice.PublishTime.Format(str.ParseTimeFormat),
+		}
+	}
+	if len(notices) == limit {
+		resp.NextCursor = notices[len(notices)-1].Id
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+// Package consumer 处理消息队列中的消息并持久化
+//
+// RabbitMQ至少投递一次，消息在持久化之后、确认之前失败会被重新投递，
+// 所有写入都以生产者分配的雪花id去重，重复投递的消息不会被重复保存，也不会重复增加未读数
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/rabbitmq/amqp091-go"
+	"go.uber.org/zap"
+	"star/app/constant/str"
+	"star/app/extra/tracing"
+	"star/app/models"
+	"star/app/utils/logging"
+	"star/app/utils/rabbitmq"
+)
+
+// Store 消息的持久化，消息id已存在时不做修改并返回false
+type Store interface {
+	SavePrivateMessage(message *models.PrivateMessage) (bool, error)
+	SaveGroupMessage(message *models.GroupMessage) error
+	SaveSystemMessage(message *models.SystemMessage) error
+	// SaveRemindMessage 保存或撤回提醒，返回提醒是否有变化
+	SaveRemindMessage(queue string, message *models.RemindMessage) (bool, error)
+}
+
+// Counter 用户的未读数，queue为消息所在的队列
+type Counter interface {
+	Incr(ctx context.Context, userId int64, queue string) error
+	Reset(ctx context.Context, userId int64) error
+}
+
+// Publisher 发送重试和死信消息，*amqp091.Channel实现了该接口
+type Publisher interface {
+	PublishWithContext(ctx context.Context, exchange, key string, mandatory, immediate bool, msg amqp091.Publishing) error
+}
+
+type Consumer struct {
+	store     Store
+	counter   Counter
+	publisher Publisher
+}
+
+func New(store Store, counter Counter, publisher Publisher) *Consumer {
+	return &Consumer{
+		store:     store,
+		counter:   counter,
+		publisher: publisher,
+	}
+}
+
+func newMessage(queue string) interface{} {
+	switch queue {
+	case str.MessagePrivateMsg:
+		return &models.PrivateMessage{}
+	case str.MessageGroupMsg:
+		return &models.GroupMessage{}
+	case str.MessageSystem:
+		return &models.SystemMessage{}
+	case str.MessageMention, str.MessageLike, str.MessageReply:
+		return &models.RemindMessage{}
+	}
+	return nil
+}
+
+// save 持久化消息，只有新保存的消息才更新未读数
+func (c *Consumer) save(ctx context.Context, queue string, message interface{}) error {
+	switch message := message.(type) {
+	case *models.PrivateMessage:
+		inserted, err := c.store.SavePrivateMessage(message)
+		if err != nil {
+			return err
+		}
+		//私信保存时都是未读，由MarkChatRead标记已读
+		if inserted {
+			c.incrCount(ctx, message.RecipientId, queue)
+		}
+	case *models.GroupMessage:
+		return c.store.SaveGroupMessage(message)
+	case *models.SystemMessage:
+		//通知在用户拉取时才写入用户的通知列表并计入未读数
+		return c.store.SaveSystemMessage(message)
+	case *models.RemindMessage:
+		changed, err := c.store.SaveRemindMessage(queue, message)
+		if err != nil || !changed {
+			return err
+		}
+		//撤回的提醒不确定是否已读，直接删除未读数缓存
+		if message.IsDeleted {
+			if err := c.counter.Reset(ctx, message.RecipientId); err != nil {
+				zap.L().Error("delete message count error",
+					zap.Error(err),
+					zap.Int64("userId", message.RecipientId))
+			}
+			return nil
+		}
+		c.incrCount(ctx, message.RecipientId, queue)
+	}
+	return nil
+}
+
+// incrCount 增加未读数，消息已持久化，失败时只记录日志，避免消息重新投递
+func (c *Consumer) incrCount(ctx context.Context, userId int64, queue string) {
+	if err := c.counter.Incr(ctx, userId, queue); err != nil {
+		zap.L().Error("incr message count error",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.String("queue", queue))
+	}
+}
+
+// Handle 处理队列中的消息直到delivery被关闭
+func (c *Consumer) Handle(delivery <-chan amqp091.Delivery, queue string) {
+	for msg := range delivery {
+		ctx := rabbitmq.ExtractAMQPHeaders(context.Background(), msg.Headers)
+
+		ctx, span := tracing.Tracer.Start(ctx, "MessageSendService")
+		logger := logging.LogServiceWithTrace(span, "MessageSend")
+		message := newMessage(queue)
+		if message == nil {
+			c.sendDeadLetter(msg, queue, "unknown queue", rabbitmq.AppendAttempt(msg.Headers, "unknown queue"), span, logger)
+			span.End()
+			continue
+		}
+
+		// 反序列化消息体
+		if err := json.Unmarshal(msg.Body, message); err != nil {
+			logger.Error(fmt.Sprintf("unmarshal %s message error", queue),
+				zap.ByteString("message body", msg.Body),
+				zap.Error(err))
+			//序列化失败，重试也无法处理，直接投递到死信队列
+			reason := "unmarshal error: " + err.Error()
+			c.sendDeadLetter(msg, queue, reason, rabbitmq.AppendAttempt(msg.Headers, reason), span, logger)
+			logging.SetSpanError(span, err)
+			span.End()
+			continue
+		}
+
+		// 更新消息到数据库
+		if err := c.save(ctx, queue, message); err != nil {
+			logger.Error(fmt.Sprintf("insert %s message error", queue),
+				zap.Error(err),
+				zap.Any("message", message))
+			c.sendRetryMessage(msg, queue, err, span, logger)
+			logging.SetSpanError(span, err)
+			span.End()
+			continue
+		}
+
+		// 成功处理消息，确认 (ack)
+		if err := msg.Ack(false); err != nil {
+			logger.Error(fmt.Sprintf("ack %s message error", queue),
+				zap.Error(err))
+			logging.SetSpanError(span, err)
+		}
+		span.End()
+	}
+}
@@ -0,0 +1,162 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel"
+	"star/app/constant/str"
+	"star/app/extra/tracing"
+	"star/app/models"
+	"star/app/utils/rabbitmq"
+	"testing"
+)
+
+// memStore 以消息id去重的内存存储，与mysql的insert ignore语义一致，前failures次写入失败
+type memStore struct {
+	failures int
+	private  map[int64]*models.PrivateMessage
+}
+
+func (s *memStore) SavePrivateMessage(message *models.PrivateMessage) (bool, error) {
+	if s.failures > 0 {
+		s.failures--
+		return false, errors.New("database unavailable")
+	}
+	if _, ok := s.private[message.Id]; ok {
+		return false, nil
+	}
+	s.private[message.Id] = message
+	return true, nil
+}
+
+func (s *memStore) SaveGroupMessage(message *models.GroupMessage) error   { return nil }
+func (s *memStore) SaveSystemMessage(message *models.SystemMessage) error { return nil }
+func (s *memStore) SaveRemindMessage(queue string, message *models.RemindMessage) (bool, error) {
+	return false, nil
+}
+
+// memCounter 记录未读数增加的次数
+type memCounter struct {
+	incrs int
+}
+
+func (c *memCounter) Incr(ctx context.Context, userId int64, queue string) error {
+	c.incrs++
+	return nil
+}
+
+func (c *memCounter) Reset(ctx context.Context, userId int64) error { return nil }
+
+// memBroker 内存中的消息队列，重试消息立即重新投递，deadErr模拟死信交换机不可用
+type memBroker struct {
+	tag      uint64
+	pending  []amqp091.Delivery
+	acks     int
+	requeues int
+	retries  []amqp091.Publishing
+	dead     []amqp091.Publishing
+	deadErr  error
+}
+
+func (b *memBroker) deliver(body []byte, headers amqp091.Table) {
+	b.tag++
+	b.pending = append(b.pending, amqp091.Delivery{Acknowledger: b, DeliveryTag: b.tag, Headers: headers, Body: body})
+}
+
+func (b *memBroker) PublishWithContext(ctx context.Context, exchange, key string, mandatory, immediate bool, msg amqp091.Publishing) error {
+	if exchange == str.DeadLetterExchange {
+		if b.deadErr != nil {
+			return b.deadErr
+		}
+		b.dead = append(b.dead, msg)
+		return nil
+	}
+	b.retries = append(b.retries, msg)
+	b.deliver(msg.Body, msg.Headers)
+	return nil
+}
+
+func (b *memBroker) Ack(tag uint64, multiple bool) error {
+	b.acks++
+	return nil
+}
+
+func (b *memBroker) Nack(tag uint64, multiple bool, requeue bool) error {
+	if requeue {
+		b.requeues++
+	}
+	return nil
+}
+
+func (b *memBroker) Reject(tag uint64, requeue bool) error {
+	return b.Nack(tag, false, requeue)
+}
+
+// run 逐条投递私信直到队列为空
+func (b *memBroker) run(c *Consumer) {
+	for len(b.pending) > 0 {
+		delivery := make(chan amqp091.Delivery, 1)
+		delivery <- b.pending[0]
+		b.pending = b.pending[1:]
+		close(delivery)
+		c.Handle(delivery, str.MessagePrivateMsg)
+	}
+}
+
+func newTestConsumer(t *testing.T, failures int) (*memStore, *memCounter, *memBroker) {
+	if tracing.Tracer == nil {
+		tracing.Tracer = otel.Tracer("test")
+	}
+	store := &memStore{failures: failures, private: make(map[int64]*models.PrivateMessage)}
+	counter := new(memCounter)
+	broker := new(memBroker)
+	body, err := json.Marshal(&models.PrivateMessage{Id: 1, SenderId: 2, RecipientId: 3, Content: "hi"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	broker.deliver(body, nil)
+	broker.deliver(body, nil)
+	broker.run(New(store, counter, broker))
+	return store, counter, broker
+}
+
+// TestConsumerIdempotent 重复投递的消息只保存一次、只计一次未读数
+func TestConsumerIdempotent(t *testing.T) {
+	store, counter, broker := newTestConsumer(t, 0)
+	if len(store.private) != 1 || counter.incrs != 1 || broker.acks != 2 {
+		t.Fatalf("unexpected state: saved=%d unread=%d acks=%d", len(store.private), counter.incrs, broker.acks)
+	}
+}
+
+// TestConsumerRetry 写入失败的消息重试成功后保存
+func TestConsumerRetry(t *testing.T) {
+	store, counter, broker := newTestConsumer(t, 2)
+	if len(store.private) != 1 || counter.incrs != 1 || len(broker.retries) != 2 || len(broker.dead) != 0 {
+		t.Fatalf("unexpected state: saved=%d unread=%d retries=%d dead=%d",
+			len(store.private), counter.incrs, len(broker.retries), len(broker.dead))
+	}
+}
+
+// TestConsumerDeadLetter 超过最大重试次数的消息带着失败记录进入死信队列
+func TestConsumerDeadLetter(t *testing.T) {
+	_, _, broker := newTestConsumer(t, 2*(MaxRetries+1))
+	if len(broker.dead) != 2 || len(broker.retries) != 2*MaxRetries {
+		t.Fatalf("unexpected state: retries=%d dead=%d", len(broker.retries), len(broker.dead))
+	}
+	if attempts, _ := broker.dead[0].Headers[rabbitmq.HeaderAttempts].([]interface{}); len(attempts) != MaxRetries+1 {
+		t.Fatalf("expected %d attempts, got %d", MaxRetries+1, len(attempts))
+	}
+}
+
+// TestConsumerDeadLetterUnavailable 死信交换机不可用时消息重新入队，不能被丢弃
+func TestConsumerDeadLetterUnavailable(t *testing.T) {
+	store := &memStore{private: make(map[int64]*models.PrivateMessage)}
+	broker := &memBroker{deadErr: errors.New("channel closed")}
+	broker.deliver([]byte("not json"), nil)
+	broker.run(New(store, new(memCounter), broker))
+	if broker.acks != 0 || broker.requeues != 1 {
+		t.Fatalf("unexpected state: acks=%d requeues=%d", broker.acks, broker.requeues)
+	}
+}
@@ -0,0 +1,119 @@
+package consumer
+
+import (
+	"context"
+	"github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"star/app/constant/str"
+	"star/app/utils/logging"
+	"star/app/utils/rabbitmq"
+	"time"
+)
+
+const (
+	//MaxRetries 最大重试次数
+	MaxRetries = 3
+	//第一次重试的延迟，之后每次翻倍
+	retryBaseDelay = time.Second
+	retryMaxDelay  = time.Minute
+)
+
+// retryDelay 第retryCount+1次重试的延迟，单位毫秒
+func retryDelay(retryCount int32) int64 {
+	delay := retryBaseDelay << retryCount
+	if delay <= 0 || delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+	return delay.Milliseconds()
+}
+
+// sendRetryMessage 将处理失败的消息延迟重新投递，超过最大重试次数后投递到死信队列
+func (c *Consumer) sendRetryMessage(msg amqp091.Delivery, queue string, cause error, span trace.Span, logger *zap.Logger) {
+	retryCount := rabbitmq.RetryCount(msg.Headers)
+	attempts := rabbitmq.AppendAttempt(msg.Headers, cause.Error())
+	if retryCount >= MaxRetries {
+		c.sendDeadLetter(msg, queue, "max retries exceeded: "+cause.Error(), attempts, span, logger)
+		return
+	}
+
+	headers := rabbitmq.CopyHeaders(msg.Headers)
+	headers[rabbitmq.HeaderRetryCount] = retryCount + 1
+	headers[rabbitmq.HeaderDelay] = retryDelay(retryCount)
+	headers[rabbitmq.HeaderAttempts] = attempts
+	err := c.publisher.PublishWithContext(
+		context.Background(),
+		str.RetryExchange,
+		msg.RoutingKey,
+		false,
+		false,
+		amqp091.Publishing{
+			Headers:      headers,
+			Body:         msg.Body,
+			ContentType:  msg.ContentType,
+			DeliveryMode: amqp091.Persistent,
+		},
+	)
+	if err != nil {
+		// 重试消息发送失败，重新入队等待下次处理
+		logger.Error("send retry message fail",
+			zap.Error(err),
+			zap.ByteString("message body", msg.Body))
+		logging.SetSpanError(span, err)
+		if nackErr := msg.Nack(false, true); nackErr != nil {
+			logger.Error("nack message error",
+				zap.ByteString("message body", msg.Body),
+				zap.Error(nackErr))
+		}
+		return
+	}
+	if err := msg.Ack(false); err != nil {
+		logger.Error("ack msg error",
+			zap.Error(err))
+	}
+}
+
+// sendDeadLetter 将消息连同失败原因和失败记录投递到死信队列
+func (c *Consumer) sendDeadLetter(msg amqp091.Delivery, queue string, reason string, attempts []interface{}, span trace.Span, logger *zap.Logger) {
+	headers := rabbitmq.CopyHeaders(msg.Headers)
+	delete(headers, rabbitmq.HeaderDelay)
+	headers[rabbitmq.HeaderAttempts] = attempts
+	headers[rabbitmq.HeaderDeadReason] = reason
+	headers[rabbitmq.HeaderDeadQueue] = queue
+	headers[rabbitmq.HeaderDeadAt] = time.Now().UTC().Format(time.RFC3339)
+	err := c.publisher.PublishWithContext(
+		context.Background(),
+		str.DeadLetterExchange,
+		msg.RoutingKey,
+		false,
+		false,
+		amqp091.Publishing{
+			Headers:      headers,
+			Body:         msg.Body,
+			ContentType:  msg.ContentType,
+			DeliveryMode: amqp091.Persistent,
+		},
+	)
+	if err != nil {
+		//源队列没有配置死信交换机，丢弃会丢失消息，重新入队等待死信交换机恢复
+		logger.Error("send dead letter fail, message requeued",
+			zap.Error(err),
+			zap.String("reason", reason),
+			zap.ByteString("message body", msg.Body))
+		logging.SetSpanError(span, err)
+		if nackErr := msg.Nack(false, true); nackErr != nil {
+			logger.Error("nack message error",
+				zap.ByteString("message body", msg.Body),
+				zap.Error(nackErr))
+		}
+		return
+	}
+	logger.Warn("message moved to dead letter queue",
+		zap.String("queue", queue),
+		zap.String("reason", reason),
+		zap.Int("attempts", len(attempts)))
+	if err := msg.Ack(false); err != nil {
+		logger.Error("ack msg error",
+			zap.Error(err))
+	}
+}
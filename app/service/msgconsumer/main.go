@@ -2,18 +2,18 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/rabbitmq/amqp091-go"
 	"go.uber.org/zap"
+	"os"
+	"os/signal"
 	"star/app/constant/str"
 	"star/app/extra/tracing"
-	"star/app/models"
-	"star/app/storage/mysql"
+	"star/app/service/msgconsumer/consumer"
 	"star/app/utils/logging"
 	"star/app/utils/rabbitmq"
 	"star/app/utils/snowflake"
-	"sync"
+	"syscall"
 )
 
 var conn *amqp091.Connection
@@ -24,6 +24,14 @@ func failOnError(err error, msg string) {
 		zap.L().Error(msg, zap.Error(err))
 	}
 }
+
+// fatalOnError 缺少连接、交换机、队列或消费者时无法正常消费，直接退出而不是带着缺失的队列继续运行
+func fatalOnError(err error, msg string) {
+	if err != nil {
+		zap.L().Error(msg, zap.Error(err))
+		panic(fmt.Sprintf("%s: %v", msg, err))
+	}
+}
 func closeMQ() {
 	if err := conn.Close(); err != nil {
 		zap.L().Error("close rabbitmq conn error", zap.Error(err))
@@ -40,195 +48,145 @@ func main() {
 	if err := snowflake.Init(1); err != nil {
 		panic(err)
 	}
+	tp, err := tracing.SetTraceProvider(str.MsgConsumer)
+	if err != nil {
+		logging.Logger.Error("set tracer error",
+			zap.Error(err))
+		return
+	}
+	defer func() {
+		if err := tp.Shutdown(context.Background()); err != nil {
+			logging.Logger.Error("set tracer error",
+				zap.Error(err))
+			return
+		}
+	}()
 	//连接消息队列
-	var err error
 	conn, err = amqp091.Dial(rabbitmq.ReturnRabbitmqUrl())
-	failOnError(err, "Failed to connect to RabbitMQ")
+	fatalOnError(err, "Failed to connect to RabbitMQ")
 
 	channel, err = conn.Channel()
-	failOnError(err, "Failed to open a channel")
+	fatalOnError(err, "Failed to open a channel")
 	defer closeMQ()
 
 	err = channel.ExchangeDeclare(str.MessageExchange,
 		"topic",
 		false, false, false, false,
 		nil)
-	failOnError(err, "Failed to declare an exchange")
+	fatalOnError(err, "Failed to declare an exchange")
 	err = channel.ExchangeDeclare(str.RetryExchange,
 		"x-delayed-message",
 		false, false, false, false,
 		amqp091.Table{
 			"x-delayed-type": "topic",
 		})
+	fatalOnError(err, "Failed to declare a retry exchange")
 	//声明队列
 	_, err = channel.QueueDeclare(str.MessageLike,
 		false, false, false, false,
 		nil)
-	failOnError(err, "Failed to declare a like queue")
+	fatalOnError(err, "Failed to declare a like queue")
 	_, err = channel.QueueDeclare(str.MessageReply,
 		false, false, false, false,
 		nil)
-	failOnError(err, "Failed to declare a reply queue")
+	fatalOnError(err, "Failed to declare a reply queue")
 	_, err = channel.QueueDeclare(str.MessageSystem,
 		false, false, false, false,
 		nil)
-	failOnError(err, "Failed to declare a system queue")
+	fatalOnError(err, "Failed to declare a system queue")
 	_, err = channel.QueueDeclare(str.MessagePrivateMsg,
 		false, false, false, false,
 		nil)
-	failOnError(err, "Failed to declare a private_msg queue")
+	fatalOnError(err, "Failed to declare a private_msg queue")
 	_, err = channel.QueueDeclare(str.MessageMention,
 		false, false, false, false,
 		nil)
-	failOnError(err, "Failed to declare a mention queue")
+	fatalOnError(err, "Failed to declare a mention queue")
+	_, err = channel.QueueDeclare(str.MessageGroupMsg,
+		false, false, false, false,
+		nil)
+	fatalOnError(err, "Failed to declare a group_msg queue")
 	//绑定队列
 	// 绑定点赞消息
 	err = channel.QueueBind(str.MessageLike, str.RoutMessageLike, str.MessageExchange, false, nil)
-	failOnError(err, "Failed to bind like queue")
+	fatalOnError(err, "Failed to bind like queue")
 	err = channel.QueueBind(str.MessageLike, str.RoutMessageLike, str.RetryExchange, false, nil)
-	failOnError(err, "Failed to bind like queue")
+	fatalOnError(err, "Failed to bind like queue")
 
 	// 绑定@提及消息
 	err = channel.QueueBind(str.MessageMention, str.RoutMention, str.MessageExchange, false, nil)
-	failOnError(err, "Failed to bind mention queue")
+	fatalOnError(err, "Failed to bind mention queue")
 	err = channel.QueueBind(str.MessageMention, str.RoutMention, str.RetryExchange, false, nil)
-	failOnError(err, "Failed to bind mention queue")
+	fatalOnError(err, "Failed to bind mention queue")
 
 	// 绑定回复消息
-	err = channel.QueueBind(str.MessageReply, str.RoutMention, str.MessageExchange, false, nil)
-	failOnError(err, "Failed to bind reply queue")
-	err = channel.QueueBind(str.MessageReply, str.RoutMention, str.RetryExchange, false, nil)
-	failOnError(err, "Failed to bind reply queue")
+	err = channel.QueueBind(str.MessageReply, str.RoutReply, str.MessageExchange, false, nil)
+	fatalOnError(err, "Failed to bind reply queue")
+	err = channel.QueueBind(str.MessageReply, str.RoutReply, str.RetryExchange, false, nil)
+	fatalOnError(err, "Failed to bind reply queue")
+	// 解除回复队列上旧的@提及路由绑定，否则@提及会被重复记为回复
+	err = channel.QueueUnbind(str.MessageReply, str.RoutMention, str.MessageExchange, nil)
+	failOnError(err, "Failed to unbind reply queue")
+	err = channel.QueueUnbind(str.MessageReply, str.RoutMention, str.RetryExchange, nil)
+	failOnError(err, "Failed to unbind reply queue")
 
 	// 绑定系统通知
 	err = channel.QueueBind(str.MessageSystem, str.RoutSystem, str.MessageExchange, false, nil)
-	failOnError(err, "Failed to bind system queue")
+	fatalOnError(err, "Failed to bind system queue")
 	err = channel.QueueBind(str.MessageSystem, str.RoutSystem, str.RetryExchange, false, nil)
-	failOnError(err, "Failed to bind system queue")
+	fatalOnError(err, "Failed to bind system queue")
 
 	// 绑定私信消息
 	err = channel.QueueBind(str.MessagePrivateMsg, str.RoutPrivateMsg, str.MessageExchange, false, nil)
-	failOnError(err, "Failed to bind private message queue")
+	fatalOnError(err, "Failed to bind private message queue")
 	err = channel.QueueBind(str.MessagePrivateMsg, str.RoutPrivateMsg, str.RetryExchange, false, nil)
-	failOnError(err, "Failed to bind private message queue")
-	var wg sync.WaitGroup
-	wg.Add(1)
-
-	go savePrivateMessage()
-
-	go saveSystemMessage()
-
-	go saveMentionMessage()
-
-	go saveReplyMessage()
-
-	go saveLikeMessage()
-	wg.Wait()
-}
-
-func savePrivateMessage() {
-	delivery, err := channel.Consume(str.MessagePrivateMsg,
-		str.Empty, false, false, false, false, nil)
-	failOnError(err, "Failed to register a privateMsg consumer")
-	handleMessage(delivery, func(message interface{}) error {
-		return mysql.InsertPrivateMsg(message.(*models.PrivateMessage))
-	}, str.MessagePrivateMsg)
-}
-
-func saveSystemMessage() {
-	delivery, err := channel.Consume(str.MessageSystem,
-		str.Empty, false, false, false, false, nil)
-	failOnError(err, "Failed to register a privateMsg consumer")
-	handleMessage(delivery, func(message interface{}) error {
-		return mysql.InsertSystemMsg(message.(*models.SystemMessage))
-	}, str.MessageSystem)
-}
-func saveLikeMessage() {
-	delivery, err := channel.Consume(str.MessageLike,
-		str.Empty, false, false, false, false, nil)
-	failOnError(err, "Failed to register a like consumer")
-	handleMessage(delivery, func(message interface{}) error {
-		return mysql.UpdateLikeMessage(message.(*models.RemindMessage))
-	}, str.MessageLike)
-}
-func saveMentionMessage() {
-	delivery, err := channel.Consume(str.MessageMention,
-		str.Empty, false, false, false, false, nil)
-	failOnError(err, "Failed to register a mention consumer")
-	handleMessage(delivery, func(message interface{}) error {
-		return mysql.InsertMentionMessage(message.(*models.RemindMessage))
-	}, str.MessageMention)
-}
-
-func saveReplyMessage() {
-	delivery, err := channel.Consume(str.MessageReply,
-		str.Empty, false, false, false, false, nil)
-	failOnError(err, "Failed to register a reply consumer")
-	handleMessage(delivery, func(message interface{}) error {
-		return mysql.InsertReplyMessage(message.(*models.RemindMessage))
-	}, str.MessageReply)
-}
-
-func getFuncNewInstance(msgType string) func() interface{} {
-	switch msgType {
-	case str.MessagePrivateMsg:
-		return func() interface{} {
-			return &models.PrivateMessage{}
-		}
-	case str.MessageSystem:
-		return func() interface{} {
-			return &models.SystemMessage{}
-		}
-	case str.MessageMention, str.MessageLike, str.MessageReply:
-		return func() interface{} {
-			return &models.RemindMessage{}
-		}
+	fatalOnError(err, "Failed to bind private message queue")
+
+	// 绑定群聊消息
+	err = channel.QueueBind(str.MessageGroupMsg, str.RoutGroupMsg, str.MessageExchange, false, nil)
+	fatalOnError(err, "Failed to bind group message queue")
+	err = channel.QueueBind(str.MessageGroupMsg, str.RoutGroupMsg, str.RetryExchange, false, nil)
+	fatalOnError(err, "Failed to bind group message queue")
+
+	// 声明死信交换机和各队列对应的死信队列，死信需要保留到人工处理，使用持久化的交换机和队列
+	err = channel.ExchangeDeclare(str.DeadLetterExchange,
+		"direct",
+		true, false, false, false,
+		nil)
+	fatalOnError(err, "Failed to declare a dead letter exchange")
+	for queue, routingKey := range messageRoutes {
+		deadQueue := rabbitmq.DeadLetterQueue(queue)
+		_, err = channel.QueueDeclare(deadQueue,
+			true, false, false, false,
+			nil)
+		fatalOnError(err, "Failed to declare a dead letter queue")
+		err = channel.QueueBind(deadQueue, routingKey, str.DeadLetterExchange, false, nil)
+		fatalOnError(err, "Failed to bind dead letter queue")
+	}
+	msgConsumer := consumer.New(mysqlStore{}, redisCounter{}, channel)
+	//先注册所有队列的消费者，任何一个注册失败都不启动
+	deliveries := make(map[string]<-chan amqp091.Delivery, len(messageRoutes))
+	for queue := range messageRoutes {
+		delivery, err := channel.Consume(queue,
+			str.Empty, false, false, false, false, nil)
+		fatalOnError(err, fmt.Sprintf("Failed to register a %s consumer", queue))
+		deliveries[queue] = delivery
+	}
+	for queue, delivery := range deliveries {
+		go msgConsumer.Handle(delivery, queue)
 	}
-	return nil
+	//收到退出信号后关闭连接
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
 }
 
-func handleMessage(delivery <-chan amqp091.Delivery, updateFunc func(interface{}) error, msgType string) {
-	getInstance := getFuncNewInstance(msgType)
-	for msg := range delivery {
-		ctx := rabbitmq.ExtractAMQPHeaders(context.Background(), msg.Headers)
-
-		ctx, span := tracing.Tracer.Start(ctx, "MessageSendService")
-		logger := logging.LogServiceWithTrace(span, "MessageSend")
-		message := getInstance()
-
-		// 反序列化消息体
-		if err := json.Unmarshal(msg.Body, message); err != nil {
-			logger.Error(fmt.Sprintf("unmarshal %s message error", msgType),
-				zap.ByteString("message body", msg.Body),
-				zap.Error(err))
-			//序列化失败，拒绝消息且不重新入队
-			if nackErr := msg.Nack(false, false); nackErr != nil {
-				logging.Logger.Error("nack message error",
-					zap.ByteString("message body", msg.Body),
-					zap.Error(nackErr))
-			}
-			logging.SetSpanError(span, err)
-			span.End()
-			continue
-		}
-
-		// 更新消息到数据库
-		if err := updateFunc(message); err != nil {
-			logger.Error(fmt.Sprintf("insert %s message error", msgType),
-				zap.Error(err),
-				zap.Any("message", message))
-			sendRetryMessage(msg, 100, span, logger)
-			logging.SetSpanError(span, err)
-			span.End()
-			continue
-		}
-
-		// 成功处理消息，确认 (ack)
-		if err := msg.Ack(false); err != nil {
-			logger.Error(fmt.Sprintf("ack %s message error", msgType),
-				zap.Error(err))
-			logging.SetSpanError(span, err)
-		}
-		span.End()
-	}
+// 消息队列对应的路由键，死信按原路由键进入对应的死信队列
+var messageRoutes = map[string]string{
+	str.MessageLike:       str.RoutMessageLike,
+	str.MessageMention:    str.RoutMention,
+	str.MessageReply:      str.RoutReply,
+	str.MessageSystem:     str.RoutSystem,
+	str.MessagePrivateMsg: str.RoutPrivateMsg,
+	str.MessageGroupMsg:   str.RoutGroupMsg,
 }
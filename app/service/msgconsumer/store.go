@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"star/app/constant/str"
+	"star/app/models"
+	"star/app/storage/mysql"
+	"star/app/storage/redis"
+)
+
+// mysqlStore 将消息持久化到mysql
+type mysqlStore struct{}
+
+func (mysqlStore) SavePrivateMessage(message *models.PrivateMessage) (bool, error) {
+	return mysql.InsertPrivateMsg(message)
+}
+
+func (mysqlStore) SaveGroupMessage(message *models.GroupMessage) error {
+	return mysql.InsertGroupMessage(message)
+}
+
+func (mysqlStore) SaveSystemMessage(message *models.SystemMessage) error {
+	return mysql.InsertSystemMsg(message)
+}
+
+func (mysqlStore) SaveRemindMessage(queue string, message *models.RemindMessage) (bool, error) {
+	switch queue {
+	case str.MessageLike:
+		return mysql.UpdateLikeMessage(message)
+	case str.MessageMention:
+		return mysql.UpdateMentionMessage(message)
+	case str.MessageReply:
+		return mysql.UpdateReplyMessage(message)
+	}
+	return false, errors.New("unknown remind queue " + queue)
+}
+
+// 消息队列对应的未读数类型
+var countFields = map[string]string{
+	str.MessageLike:       redis.CountLike,
+	str.MessageMention:    redis.CountMention,
+	str.MessageReply:      redis.CountReply,
+	str.MessagePrivateMsg: redis.CountPrivateMsg,
+}
+
+// redisCounter 更新redis中缓存的未读数
+type redisCounter struct{}
+
+func (redisCounter) Incr(ctx context.Context, userId int64, queue string) error {
+	field, ok := countFields[queue]
+	if !ok {
+		return nil
+	}
+	return redis.IncrMessageCount(ctx, userId, field, 1)
+}
+
+func (redisCounter) Reset(ctx context.Context, userId int64) error {
+	return redis.DelMessageCount(ctx, userId)
+}
@@ -7,6 +7,7 @@ import (
 	"star/app/constant/settings"
 	"star/app/constant/str"
 	"star/app/extra/tracing"
+	"star/app/storage/file"
 	"star/app/utils/logging"
 	"star/app/utils/snowflake"
 	"star/proto/publish/publishPb"
@@ -35,6 +36,12 @@ func main() {
 		}
 	}()
 
+	//初始化文件存储，合并后的视频上传到配置的存储
+	if err := file.Init(); err != nil {
+		logging.Logger.Error("init file storage error",
+			zap.Error(err))
+		return
+	}
 	publishSrvIns.New()
 	//etcd注册件
 	etcdReg := etcd.NewRegistry(
@@ -3,13 +3,16 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/go-redis/redis_rate/v10"
 	"github.com/google/uuid"
 	redis2 "github.com/redis/go-redis/v9"
+	"github.com/robfig/cron/v3"
 	"go-micro.dev/v4"
 	"go.uber.org/zap"
 	"path/filepath"
+	"star/app/constant/settings"
 	"star/app/constant/str"
 	"star/app/extra/tracing"
 	"star/app/models"
@@ -17,22 +20,41 @@ import (
 	"star/app/storage/mysql"
 	"star/app/storage/redis"
 	"star/app/utils/logging"
+	"star/app/utils/mention"
+	"star/app/utils/rbac"
 	"star/app/utils/snowflake"
 	"star/proto/feed/feedPb"
+	"star/proto/message/messagePb"
 	"star/proto/publish/publishPb"
+	"star/proto/user/userPb"
 	"strconv"
 	"time"
 )
 
+// 每批推送的粉丝数
+const fanOutBatchSize = 500
+
 type PublishSrv struct {
 }
 
 var feedService feedPb.FeedService
+var userService userPb.UserService
+var messageService messagePb.MessageService
 var publishSrvIns *PublishSrv
 
 func (p *PublishSrv) New() {
 	feedMicroService := micro.NewService(micro.Name(str.FeedServiceClient))
 	feedService = feedPb.NewFeedService(str.FeedService, feedMicroService.Client())
+
+	userMicroService := micro.NewService(micro.Name(str.UserServiceClient))
+	userService = userPb.NewUserService(str.UserService, userMicroService.Client())
+
+	messageMicroService := micro.NewService(micro.Name(str.MessageServiceClient))
+	messageService = messagePb.NewMessageService(str.MessageService, messageMicroService.Client())
+
+	cronRunner := cron.New()
+	cronRunner.AddFunc("@hourly", cleanTempUploads)
+	cronRunner.Start()
 }
 
 func publishLimitKey(userId int64) string {
@@ -48,6 +70,12 @@ func (p *PublishSrv) PreUploadVideos(ctx context.Context, req *publishPb.PreUplo
 	logging.SetSpanWithHostname(span)
 	logger := logging.LogServiceWithTrace(span, "PublishService.PreUploadVideos")
 
+	if req.Chunks == 0 {
+		logger.Error("invalid video chunks",
+			zap.Int64("userId", req.ActorId),
+			zap.Uint32("chunks", req.Chunks))
+		return str.ErrInvalidParam
+	}
 	//redis limit
 	limiter := redis_rate.NewLimiter(redis.Client)
 	limiterKey := publishLimitKey(req.ActorId)
@@ -81,7 +109,7 @@ func (p *PublishSrv) PreUploadVideos(ctx context.Context, req *publishPb.PreUplo
 		logging.SetSpanError(span, err)
 		return str.ErrPublishError
 	}
-	err = redis.Client.Expire(ctx, uploadVideosKey(upload.UploadId, req.ActorId), 24*time.Hour).Err()
+	err = redis.Client.Expire(ctx, uploadVideosKey(upload.UploadId, req.ActorId), uploadExpire).Err()
 	if err != nil {
 		logger.Error("redis set expire error",
 			zap.Error(err),
@@ -123,6 +151,7 @@ func (p *PublishSrv) CreatePost(ctx context.Context, req *publishPb.CreatePostRe
 		Star:        0,
 		Collection:  0,
 		Content:     req.Content,
+		Video:       req.Video,
 		IsScan:      req.IsScan,
 		CommunityId: req.CommunityId,
 	}
@@ -202,9 +231,227 @@ func (p *PublishSrv) CreatePost(ctx context.Context, req *publishPb.CreatePostRe
 		logging.SetSpanError(span, err)
 		return str.ErrPublishError
 	}
+	go fanOutPost(post, logger)
+	go mention.Remind(context.Background(), userService, messageService, post.UserId, "feed", post.PostId, post.Content, "", logger)
+	resp.PostId = post.PostId
+	return nil
+}
+
+// fanOutPost 将新帖子推送到粉丝的收件箱，粉丝数超过阈值的作者不推送，由粉丝读取时拉取
+func fanOutPost(post *models.Post, logger *zap.Logger) {
+	if !post.IsScan {
+		return
+	}
+	ctx := context.Background()
+	fansCount, err := mysql.GetFansCount(post.UserId)
+	if err != nil {
+		logger.Error("get fans count error",
+			zap.Error(err),
+			zap.Int64("userId", post.UserId))
+		return
+	}
+	isBigV := fansCount > settings.Conf.FeedConfig.GetFanOutThreshold()
+	if err := redis.MarkBigV(ctx, post.UserId, isBigV); err != nil {
+		logger.Error("mark bigV error",
+			zap.Error(err),
+			zap.Int64("userId", post.UserId))
+		return
+	}
+	if isBigV {
+		return
+	}
+	fansIds, err := mysql.GetAllFansIdList(post.UserId)
+	if err != nil {
+		logger.Error("get fans id list error",
+			zap.Error(err),
+			zap.Int64("userId", post.UserId))
+		return
+	}
+	capLen := settings.Conf.FeedConfig.GetTimelineLength()
+	for start := 0; start < len(fansIds); start += fanOutBatchSize {
+		end := min(start+fanOutBatchSize, len(fansIds))
+		if err := redis.PushTimeline(ctx, fansIds[start:end], post.PostId, capLen); err != nil {
+			logger.Error("push post to timeline error",
+				zap.Error(err),
+				zap.Int64("postId", post.PostId),
+				zap.Int64("userId", post.UserId))
+		}
+	}
+}
+
+// UpdatePost 修改帖子
+func (p *PublishSrv) UpdatePost(ctx context.Context, req *publishPb.UpdatePostRequest, resp *publishPb.UpdatePostResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "UpdatePostService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "PublishService.UpdatePost")
+
+	post, err := getOwnPost(req.PostId, req.ActorId)
+	if err != nil {
+		logger.Error("get own post error",
+			zap.Error(err),
+			zap.Int64("postId", req.PostId),
+			zap.Int64("actorId", req.ActorId))
+		logging.SetSpanError(span, err)
+		return err
+	}
+	//未传可见性时保持原来的可见性
+	isScan := post.IsScan
+	if req.IsScan != nil {
+		isScan = *req.IsScan
+	}
+	if err := mysql.UpdatePost(post, req.Content, isScan); err != nil {
+		logger.Error("mysql update post error",
+			zap.Error(err),
+			zap.Int64("postId", req.PostId),
+			zap.Int64("actorId", req.ActorId))
+		logging.SetSpanError(span, err)
+		if errors.Is(err, str.ErrPostNotExists) {
+			return str.ErrPostNotExists
+		}
+		return str.ErrPublishError
+	}
+	invalidatePostCache(ctx, post, !isScan, logger)
+	if !post.IsScan && isScan {
+		restorePost(ctx, post, logger)
+	}
+	return nil
+}
+
+// restorePost 隐藏的帖子重新公开后放回用户的帖子列表和粉丝的收件箱
+func restorePost(ctx context.Context, post *models.Post, logger *zap.Logger) {
+	cached.Delete(ctx, fmt.Sprintf("CountPost:%d", post.UserId))
+	if err := redis.RestoreListPost(ctx, post.UserId, post.PostId); err != nil {
+		logger.Error("restore post to user list post error",
+			zap.Error(err),
+			zap.Int64("postId", post.PostId),
+			zap.Int64("userId", post.UserId))
+	}
+	restored := *post
+	restored.IsScan = true
+	go fanOutPost(&restored, logger)
+}
+
+// DeletePost 删除帖子
+func (p *PublishSrv) DeletePost(ctx context.Context, req *publishPb.DeletePostRequest, resp *publishPb.DeletePostResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "DeletePostService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "PublishService.DeletePost")
+
+	post, err := getDeletablePost(req.PostId, req.ActorId)
+	if err != nil {
+		logger.Error("get deletable post error",
+			zap.Error(err),
+			zap.Int64("postId", req.PostId),
+			zap.Int64("actorId", req.ActorId))
+		logging.SetSpanError(span, err)
+		return err
+	}
+	if err := mysql.DeletePost(req.PostId, post.UserId); err != nil {
+		logger.Error("mysql delete post error",
+			zap.Error(err),
+			zap.Int64("postId", req.PostId),
+			zap.Int64("actorId", req.ActorId))
+		logging.SetSpanError(span, err)
+		if errors.Is(err, str.ErrPostNotExists) {
+			return str.ErrPostNotExists
+		}
+		return str.ErrPublishError
+	}
+	invalidatePostCache(ctx, post, true, logger)
 	return nil
 }
 
+// getOwnPost 获取帖子并校验操作者是否为作者
+func getOwnPost(postId int64, actorId int64) (*models.Post, error) {
+	post, err := mysql.GetPost(postId)
+	if err != nil {
+		if errors.Is(err, str.ErrPostNotExists) {
+			return nil, str.ErrPostNotExists
+		}
+		return nil, str.ErrPublishError
+	}
+	if post.UserId != actorId {
+		return nil, str.ErrNotPostAuthor
+	}
+	return post, nil
+}
+
+// getDeletablePost 获取帖子并校验操作者是作者，或者在帖子所在社区有删除帖子的权限
+func getDeletablePost(postId int64, actorId int64) (*models.Post, error) {
+	post, err := mysql.GetPost(postId)
+	if err != nil {
+		if errors.Is(err, str.ErrPostNotExists) {
+			return nil, str.ErrPostNotExists
+		}
+		return nil, str.ErrPublishError
+	}
+	if post.UserId == actorId {
+		return post, nil
+	}
+	if err := rbac.Authorize(actorId, models.PermPostDelete, post.CommunityId); err != nil {
+		if errors.Is(err, str.ErrPermissionDenied) {
+			return nil, str.ErrNotPostAuthor
+		}
+		return nil, str.ErrPublishError
+	}
+	return post, nil
+}
+
+// invalidatePostCache 清除所有保存了该帖子的缓存，removed为true时同时将帖子从作者列表和粉丝收件箱中移除
+func invalidatePostCache(ctx context.Context, post *models.Post, removed bool, logger *zap.Logger) {
+	keys := []string{
+		fmt.Sprintf("GetCommunityPostByTime:%d", post.CommunityId),
+		fmt.Sprintf("GetCommunityPostByNewReply:%d", post.CommunityId),
+		"GetPostByPopularity",
+	}
+	if err := redis.Client.Del(ctx, keys...).Err(); err != nil {
+		logger.Error("delete post list cache error",
+			zap.Error(err),
+			zap.Int64("postId", post.PostId),
+			zap.Strings("keys", keys))
+	}
+	if err := redis.DeletePostInfo(ctx, post.PostId); err != nil {
+		logger.Error("delete post info cache error",
+			zap.Error(err),
+			zap.Int64("postId", post.PostId))
+	}
+	cached.Delete(ctx, fmt.Sprintf("QueryPostExist:%d", post.PostId))
+	if !removed {
+		return
+	}
+	cached.Delete(ctx, fmt.Sprintf("CountPost:%d", post.UserId))
+	if err := redis.Client.LRem(ctx, fmt.Sprintf("ListPost:%d", post.UserId), 0, post.PostId).Err(); err != nil {
+		logger.Error("remove post from user list post error",
+			zap.Error(err),
+			zap.Int64("postId", post.PostId),
+			zap.Int64("userId", post.UserId))
+	}
+	go removeFromTimeline(post, logger)
+}
+
+// removeFromTimeline 将帖子从粉丝的收件箱中移除
+func removeFromTimeline(post *models.Post, logger *zap.Logger) {
+	fansIds, err := mysql.GetAllFansIdList(post.UserId)
+	if err != nil {
+		logger.Error("get fans id list error",
+			zap.Error(err),
+			zap.Int64("userId", post.UserId))
+		return
+	}
+	ctx := context.Background()
+	for start := 0; start < len(fansIds); start += fanOutBatchSize {
+		end := min(start+fanOutBatchSize, len(fansIds))
+		if err := redis.RemoveFromTimeline(ctx, fansIds[start:end], post.PostId); err != nil {
+			logger.Error("remove post from timeline error",
+				zap.Error(err),
+				zap.Int64("postId", post.PostId),
+				zap.Int64("userId", post.UserId))
+		}
+	}
+}
+
 func (p *PublishSrv) CountPost(ctx context.Context, req *publishPb.CountPostRequest, resp *publishPb.CountPostResponse) error {
 	ctx, span := tracing.Tracer.Start(ctx, "CountPostService")
 	defer span.End()
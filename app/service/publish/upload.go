@@ -0,0 +1,341 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"io"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"star/app/constant/str"
+	"star/app/extra/tracing"
+	"star/app/models"
+	"star/app/storage/file"
+	"star/app/storage/redis"
+	"star/app/utils/logging"
+	"star/proto/publish/publishPb"
+	"strconv"
+	"time"
+)
+
+const (
+	maxChunkSize = 10 << 20       //单个分片最大10M
+	uploadExpire = 24 * time.Hour //上传任务过期时间
+)
+
+func uploadChunksKey(uploadId string, userId int64) string {
+	return uploadVideosKey(uploadId, userId) + ":chunks"
+}
+
+// chunkDir 分片临时储存目录
+func chunkDir(upload *models.UploadFile) string {
+	return filepath.Join(upload.FilePath, upload.UploadId)
+}
+
+// getUpload 获取上传任务，任务不存在或过期时返回ErrUploadNotExists
+func getUpload(ctx context.Context, uploadId string, userId int64) (*models.UploadFile, error) {
+	upload := new(models.UploadFile)
+	if err := redis.Client.HGetAll(ctx, uploadVideosKey(uploadId, userId)).Scan(upload); err != nil {
+		return nil, err
+	}
+	if upload.UploadId == "" {
+		return nil, str.ErrUploadNotExists
+	}
+	return upload, nil
+}
+
+// getUploadedChunks 获取已上传的分片序号
+func getUploadedChunks(ctx context.Context, uploadId string, userId int64) ([]uint32, error) {
+	members, err := redis.Client.SMembers(ctx, uploadChunksKey(uploadId, userId)).Result()
+	if err != nil {
+		return nil, err
+	}
+	chunks := make([]uint32, 0, len(members))
+	for _, member := range members {
+		index, err := strconv.ParseUint(member, 10, 32)
+		if err != nil {
+			continue
+		}
+		chunks = append(chunks, uint32(index))
+	}
+	slices.Sort(chunks)
+	return chunks, nil
+}
+
+// UploadVideoChunk 上传视频分片，同一序号的分片重复上传只保存一次，返回已上传的分片用于断点续传
+func (p *PublishSrv) UploadVideoChunk(ctx context.Context, req *publishPb.UploadVideoChunkRequest, resp *publishPb.UploadVideoChunkResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "UploadVideoChunkService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "PublishService.UploadVideoChunk")
+
+	upload, err := getUpload(ctx, req.UploadId, req.ActorId)
+	if err != nil {
+		logger.Error("get upload error",
+			zap.Error(err),
+			zap.String("uploadId", req.UploadId),
+			zap.Int64("userId", req.ActorId))
+		logging.SetSpanError(span, err)
+		if errors.Is(err, str.ErrUploadNotExists) {
+			return err
+		}
+		return str.ErrPublishError
+	}
+	if req.ChunkIndex >= upload.Chunks || len(req.Data) == 0 || len(req.Data) > maxChunkSize {
+		logger.Error("invalid video chunk",
+			zap.String("uploadId", req.UploadId),
+			zap.Uint32("chunkIndex", req.ChunkIndex),
+			zap.Uint32("chunks", upload.Chunks),
+			zap.Int("size", len(req.Data)))
+		return str.ErrInvalidParam
+	}
+	chunksKey := uploadChunksKey(req.UploadId, req.ActorId)
+	uploaded, err := redis.Client.SIsMember(ctx, chunksKey, req.ChunkIndex).Result()
+	if err != nil {
+		logger.Error("redis check chunk uploaded error",
+			zap.Error(err),
+			zap.String("uploadId", req.UploadId),
+			zap.Uint32("chunkIndex", req.ChunkIndex))
+		logging.SetSpanError(span, err)
+		return str.ErrPublishError
+	}
+	if !uploaded {
+		if err := saveChunk(upload, req.ChunkIndex, req.Data); err != nil {
+			logger.Error("save video chunk error",
+				zap.Error(err),
+				zap.String("uploadId", req.UploadId),
+				zap.Uint32("chunkIndex", req.ChunkIndex))
+			logging.SetSpanError(span, err)
+			return str.ErrUpload
+		}
+		added, err := redis.Client.SAdd(ctx, chunksKey, req.ChunkIndex).Result()
+		if err != nil {
+			logger.Error("redis save uploaded chunk error",
+				zap.Error(err),
+				zap.String("uploadId", req.UploadId),
+				zap.Uint32("chunkIndex", req.ChunkIndex))
+			logging.SetSpanError(span, err)
+			return str.ErrPublishError
+		}
+		redis.Client.Expire(ctx, chunksKey, uploadExpire)
+		if added > 0 {
+			redis.Client.HIncrBy(ctx, uploadVideosKey(req.UploadId, req.ActorId), "chunkIndex", 1)
+		}
+	}
+	resp.UploadedChunks, err = getUploadedChunks(ctx, req.UploadId, req.ActorId)
+	if err != nil {
+		logger.Error("get uploaded chunks error",
+			zap.Error(err),
+			zap.String("uploadId", req.UploadId))
+		logging.SetSpanError(span, err)
+		return str.ErrPublishError
+	}
+	return nil
+}
+
+// saveChunk 先写入临时文件再重命名，避免连接中断时留下不完整的分片
+func saveChunk(upload *models.UploadFile, chunkIndex uint32, data []byte) error {
+	dir := chunkDir(upload)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	chunkPath := filepath.Join(dir, strconv.FormatUint(uint64(chunkIndex), 10))
+	partPath := chunkPath + ".part"
+	if err := os.WriteFile(partPath, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(partPath, chunkPath)
+}
+
+// CompleteVideoUpload 校验分片完整后合并视频并上传到配置的存储，再创建带视频的帖子
+func (p *PublishSrv) CompleteVideoUpload(ctx context.Context, req *publishPb.CompleteVideoUploadRequest, resp *publishPb.CompleteVideoUploadResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "CompleteVideoUploadService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "PublishService.CompleteVideoUpload")
+
+	lockKey := fmt.Sprintf("Lock_CompleteVideoUpload:%s:%d", req.UploadId, req.ActorId)
+	ok, err := redis.Client.SetNX(ctx, lockKey, 1, time.Minute).Result()
+	if err != nil {
+		logger.Error("get lock error",
+			zap.Error(err))
+		return str.ErrPublishError
+	}
+	if !ok {
+		return str.ErrRequestTooFrequently
+	}
+	defer redis.Client.Del(ctx, lockKey)
+
+	upload, err := getUpload(ctx, req.UploadId, req.ActorId)
+	if err != nil {
+		logger.Error("get upload error",
+			zap.Error(err),
+			zap.String("uploadId", req.UploadId),
+			zap.Int64("userId", req.ActorId))
+		logging.SetSpanError(span, err)
+		if errors.Is(err, str.ErrUploadNotExists) {
+			return err
+		}
+		return str.ErrPublishError
+	}
+	chunks, err := getUploadedChunks(ctx, req.UploadId, req.ActorId)
+	if err != nil {
+		logger.Error("get uploaded chunks error",
+			zap.Error(err),
+			zap.String("uploadId", req.UploadId))
+		logging.SetSpanError(span, err)
+		return str.ErrPublishError
+	}
+	if uint32(len(chunks)) != upload.Chunks {
+		logger.Warn("video chunks incomplete",
+			zap.String("uploadId", req.UploadId),
+			zap.Int("uploaded", len(chunks)),
+			zap.Uint32("chunks", upload.Chunks))
+		return str.ErrChunkIncomplete
+	}
+	mergedPath, err := mergeChunks(upload)
+	if err != nil {
+		logger.Error("merge video chunks error",
+			zap.Error(err),
+			zap.String("uploadId", req.UploadId))
+		logging.SetSpanError(span, err)
+		if errors.Is(err, str.ErrChunkIncomplete) {
+			return err
+		}
+		return str.ErrUpload
+	}
+	//合并后的文件只在本机，上传到配置的存储后删除
+	videoKey, videoUrl, err := putVideo(ctx, upload, mergedPath)
+	os.Remove(mergedPath)
+	if err != nil {
+		logger.Error("put video error",
+			zap.Error(err),
+			zap.String("uploadId", req.UploadId))
+		logging.SetSpanError(span, err)
+		return str.ErrUpload
+	}
+	createResp := new(publishPb.CreatePostResponse)
+	if err := p.CreatePost(ctx, &publishPb.CreatePostRequest{
+		UserId:      req.ActorId,
+		CommunityId: req.CommunityId,
+		Content:     req.Content,
+		IsScan:      req.IsScan,
+		Video:       videoUrl,
+	}, createResp); err != nil {
+		logger.Error("create video post error",
+			zap.Error(err),
+			zap.String("uploadId", req.UploadId),
+			zap.Int64("userId", req.ActorId))
+		logging.SetSpanError(span, err)
+		//保留分片，允许重试
+		if err := file.Default.Delete(ctx, videoKey); err != nil {
+			logger.Warn("delete video error",
+				zap.Error(err),
+				zap.String("key", videoKey))
+		}
+		return err
+	}
+	if err := os.RemoveAll(chunkDir(upload)); err != nil {
+		logger.Warn("remove video chunks error",
+			zap.Error(err),
+			zap.String("uploadId", req.UploadId))
+	}
+	redis.Client.Del(ctx, uploadVideosKey(req.UploadId, req.ActorId), uploadChunksKey(req.UploadId, req.ActorId))
+	resp.PostId = createResp.PostId
+	resp.VideoPath = videoUrl
+	return nil
+}
+
+// mergeChunks 按序号将分片合并为分片目录下的临时文件
+func mergeChunks(upload *models.UploadFile) (string, error) {
+	mergedPath := filepath.Join(chunkDir(upload), upload.UploadId+filepath.Ext(upload.FileName))
+	dst, err := os.Create(mergedPath)
+	if err != nil {
+		return "", err
+	}
+	defer dst.Close()
+	for i := uint32(0); i < upload.Chunks; i++ {
+		if err := appendChunk(dst, filepath.Join(chunkDir(upload), strconv.FormatUint(uint64(i), 10))); err != nil {
+			dst.Close()
+			os.Remove(mergedPath)
+			if os.IsNotExist(err) {
+				return "", str.ErrChunkIncomplete
+			}
+			return "", err
+		}
+	}
+	return mergedPath, nil
+}
+
+// putVideo 将合并后的视频上传到配置的存储，返回存储的key和访问链接
+func putVideo(ctx context.Context, upload *models.UploadFile, mergedPath string) (string, string, error) {
+	src, err := os.Open(mergedPath)
+	if err != nil {
+		return "", "", err
+	}
+	defer src.Close()
+	info, err := src.Stat()
+	if err != nil {
+		return "", "", err
+	}
+	ext := path.Ext(upload.FileName)
+	key := str.DirVideo + time.Now().Format(str.DirTimeParse) + str.Backslashes + upload.UploadId + ext
+	url, err := file.Default.Put(ctx, key, src, info.Size(), mime.TypeByExtension(ext))
+	if err != nil {
+		return "", "", err
+	}
+	return key, url, nil
+}
+
+func appendChunk(dst io.Writer, chunkPath string) error {
+	src, err := os.Open(chunkPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	_, err = io.Copy(dst, src)
+	return err
+}
+
+// cleanTempUploads 清理过期的分片临时目录
+func cleanTempUploads() {
+	_, span := tracing.Tracer.Start(context.Background(), "cleanTempUploadsService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "PublishService.cleanTempUploads")
+
+	entries, err := os.ReadDir(str.DirTemp)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logger.Error("read temp dir error",
+				zap.Error(err))
+			logging.SetSpanError(span, err)
+		}
+		return
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		day, err := time.ParseInLocation(str.YYMMDD, entry.Name(), time.Local)
+		if err != nil {
+			continue
+		}
+		//目录按创建上传任务的日期划分，当天最后创建的任务也过期后才删除
+		if time.Since(day) < uploadExpire+24*time.Hour {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(str.DirTemp, entry.Name())); err != nil {
+			logger.Error("remove expired temp dir error",
+				zap.Error(err),
+				zap.String("dir", entry.Name()))
+			continue
+		}
+		logger.Info("remove expired temp dir successfully",
+			zap.String("dir", entry.Name()))
+	}
+}
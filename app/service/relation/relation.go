@@ -7,6 +7,7 @@ import (
 	"go-micro.dev/v4"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"star/app/constant/settings"
 	"star/app/constant/str"
 	"star/app/extra/tracing"
 	"star/app/storage/cached"
@@ -18,6 +19,9 @@ import (
 	"strconv"
 )
 
+// 关注后补入收件箱的帖子数
+const backfillPostCount = 20
+
 type RelationSrv struct {
 }
 
@@ -86,6 +90,7 @@ func (r *RelationSrv) Follow(ctx context.Context, req *relationPb.FollowRequest,
 		return str.ErrRelationError
 	}
 	cached.Delete(ctx, fmt.Sprintf("IsFollow_%d_%d", req.UserId, req.BeFollowerId))
+	go backfillTimeline(req.UserId, req.BeFollowerId, logger)
 	return nil
 }
 
@@ -144,9 +149,67 @@ func (r *RelationSrv) UnFollow(ctx context.Context, req *relationPb.UnFollowRequ
 		return str.ErrRelationError
 	}
 	cached.Delete(ctx, fmt.Sprintf("IsFollow_%d_%d", req.UserId, req.UnBeFollowerId))
+	go pruneTimeline(req.UserId, req.UnBeFollowerId, logger)
 	return nil
 }
 
+// backfillTimeline 关注后将被关注者最近的帖子补入关注者的收件箱，大V的帖子在读取时拉取，不需要补入
+func backfillTimeline(userId, beFollowerId int64, logger *zap.Logger) {
+	ctx := context.Background()
+	exist, err := redis.TimelineExists(ctx, userId)
+	if err != nil {
+		logger.Error("check timeline exist error",
+			zap.Error(err),
+			zap.Int64("userId", userId))
+		return
+	}
+	//收件箱不存在时读取会全部回源mysql
+	if !exist {
+		return
+	}
+	isBigV, err := redis.IsBigV(ctx, beFollowerId)
+	if err != nil {
+		logger.Error("check bigV error",
+			zap.Error(err),
+			zap.Int64("beFollowerId", beFollowerId))
+		return
+	}
+	if isBigV {
+		return
+	}
+	postIds, err := mysql.ListPostId(beFollowerId, backfillPostCount)
+	if err != nil {
+		logger.Error("list follower post id error",
+			zap.Error(err),
+			zap.Int64("beFollowerId", beFollowerId))
+		return
+	}
+	if err := redis.BackfillTimeline(ctx, userId, postIds, settings.Conf.FeedConfig.GetTimelineLength()); err != nil {
+		logger.Error("backfill timeline error",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.Int64("beFollowerId", beFollowerId))
+	}
+}
+
+// pruneTimeline 取消关注后将被取关者的帖子从收件箱中移除
+func pruneTimeline(userId, unBeFollowerId int64, logger *zap.Logger) {
+	ctx := context.Background()
+	postIds, err := mysql.ListPostId(unBeFollowerId, settings.Conf.FeedConfig.GetTimelineLength())
+	if err != nil {
+		logger.Error("list un follower post id error",
+			zap.Error(err),
+			zap.Int64("unBeFollowerId", unBeFollowerId))
+		return
+	}
+	if err := redis.PruneTimeline(ctx, userId, postIds); err != nil {
+		logger.Error("prune timeline error",
+			zap.Error(err),
+			zap.Int64("userId", userId),
+			zap.Int64("unBeFollowerId", unBeFollowerId))
+	}
+}
+
 // GetFollowList 获取关注列表
 func (r *RelationSrv) GetFollowList(ctx context.Context, req *relationPb.GetFollowListRequest, resp *relationPb.GetFollowListResponse) error {
 	ctx, span := tracing.Tracer.Start(ctx, "GetFollowListService")
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"star/app/constant/str"
+	"star/app/extra/tracing"
+	"star/app/models"
+	"star/app/storage/redis"
+	"star/app/utils/jwt"
+	"star/app/utils/logging"
+	"star/app/utils/snowflake"
+	"star/proto/user/userPb"
+	"strconv"
+	"time"
+)
+
+// issueSession 为登录的用户创建设备会话并签发token
+func issueSession(ctx context.Context, userId int64, device string, ip string) (*userPb.LoginResponse_Token, error) {
+	now := time.Now().Unix()
+	session := &models.Session{
+		SessionId:    strconv.FormatInt(snowflake.GetID(), 10),
+		UserId:       userId,
+		Device:       device,
+		Ip:           ip,
+		RefreshId:    strconv.FormatInt(snowflake.GetID(), 10),
+		CreatedAt:    now,
+		LastActiveAt: now,
+	}
+	if err := redis.CreateSession(ctx, session, jwt.ExpireRefreshToken); err != nil {
+		return nil, err
+	}
+	accessToken, refreshToken, err := jwt.GetToken(userId, session.SessionId, session.RefreshId)
+	if err != nil {
+		return nil, err
+	}
+	return &userPb.LoginResponse_Token{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// RefreshToken 轮换refreshToken，已轮换过的refreshToken再次使用时视为泄露，撤销整个会话
+func (u *UserSrv) RefreshToken(ctx context.Context, req *userPb.RefreshTokenRequest, resp *userPb.RefreshTokenResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "RefreshTokenService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "UserService.RefreshToken")
+
+	claims, err := jwt.ParseRefreshToken(req.RefreshToken)
+	if err != nil {
+		logger.Warn("parse refresh token error",
+			zap.Error(err))
+		return str.ErrInvalidRefreshToken
+	}
+	refreshId := strconv.FormatInt(snowflake.GetID(), 10)
+	result, err := redis.RotateSession(ctx, claims.UserID, claims.SessionId, claims.ID, refreshId, req.Ip, jwt.ExpireRefreshToken)
+	if err != nil {
+		logger.Error("rotate session error",
+			zap.Error(err),
+			zap.Int64("userId", claims.UserID),
+			zap.String("sessionId", claims.SessionId))
+		logging.SetSpanError(span, err)
+		return str.ErrUserError
+	}
+	switch result {
+	case redis.SessionMissing:
+		return str.ErrSessionRevoked
+	case redis.SessionReused:
+		logger.Warn("refresh token reused, revoke session",
+			zap.Int64("userId", claims.UserID),
+			zap.String("sessionId", claims.SessionId),
+			zap.String("ip", req.Ip))
+		if err := redis.DeleteSession(ctx, claims.UserID, claims.SessionId); err != nil {
+			logger.Error("revoke session error",
+				zap.Error(err),
+				zap.Int64("userId", claims.UserID),
+				zap.String("sessionId", claims.SessionId))
+			logging.SetSpanError(span, err)
+			return str.ErrUserError
+		}
+		return str.ErrSessionRevoked
+	}
+
+	accessToken, refreshToken, err := jwt.GetToken(claims.UserID, claims.SessionId, refreshId)
+	if err != nil {
+		logger.Error("get token error",
+			zap.Error(err),
+			zap.Int64("userId", claims.UserID))
+		logging.SetSpanError(span, err)
+		return str.ErrUserError
+	}
+	resp.UserId = claims.UserID
+	resp.Token = &userPb.LoginResponse_Token{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}
+	return nil
+}
+
+// Logout 退出一个设备的登录
+func (u *UserSrv) Logout(ctx context.Context, req *userPb.LogoutRequest, resp *userPb.LogoutResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "LogoutService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "UserService.Logout")
+
+	userId, err := redis.GetSessionUserId(ctx, req.SessionId)
+	if err != nil {
+		logger.Error("get session error",
+			zap.Error(err),
+			zap.String("sessionId", req.SessionId))
+		logging.SetSpanError(span, err)
+		return str.ErrUserError
+	}
+	if userId != req.UserId {
+		return str.ErrSessionNotExists
+	}
+	if err := redis.DeleteSession(ctx, req.UserId, req.SessionId); err != nil {
+		logger.Error("delete session error",
+			zap.Error(err),
+			zap.Int64("userId", req.UserId),
+			zap.String("sessionId", req.SessionId))
+		logging.SetSpanError(span, err)
+		return str.ErrUserError
+	}
+	return nil
+}
+
+// LogoutAllDevices 退出用户在所有设备上的登录
+func (u *UserSrv) LogoutAllDevices(ctx context.Context, req *userPb.LogoutAllDevicesRequest, resp *userPb.LogoutResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "LogoutAllDevicesService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "UserService.LogoutAllDevices")
+
+	if err := redis.DeleteUserSessions(ctx, req.UserId); err != nil {
+		logger.Error("delete user sessions error",
+			zap.Error(err),
+			zap.Int64("userId", req.UserId))
+		logging.SetSpanError(span, err)
+		return str.ErrUserError
+	}
+	return nil
+}
+
+// ListSessions 获取用户已登录的设备
+func (u *UserSrv) ListSessions(ctx context.Context, req *userPb.ListSessionsRequest, resp *userPb.ListSessionsResponse) error {
+	ctx, span := tracing.Tracer.Start(ctx, "ListSessionsService")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "UserService.ListSessions")
+
+	sessions, err := redis.ListSessions(ctx, req.UserId)
+	if err != nil {
+		logger.Error("list sessions error",
+			zap.Error(err),
+			zap.Int64("userId", req.UserId))
+		logging.SetSpanError(span, err)
+		return str.ErrUserError
+	}
+	resp.Sessions = make([]*userPb.Session, 0, len(sessions))
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, &userPb.Session{
+			SessionId:    session.SessionId,
+			Device:       session.Device,
+			Ip:           session.Ip,
+			CreatedAt:    session.CreatedAt,
+			LastActiveAt: session.LastActiveAt,
+			Current:      session.SessionId == req.SessionId,
+		})
+	}
+	return nil
+}
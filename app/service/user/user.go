@@ -14,7 +14,6 @@ import (
 	"star/app/models"
 	"star/app/storage/cached"
 	"star/app/storage/mysql"
-	"star/app/utils/jwt"
 	"star/app/utils/logging"
 	"star/app/utils/password"
 	"star/app/utils/snowflake"
@@ -216,6 +215,25 @@ func (u *UserSrv) GetUserExistInformation(ctx context.Context, req *userPb.GetUs
 	return nil
 }
 
+// GetUserIdsByUsername 根据用户名批量查询用户id，用于解析@提及
+func (u *UserSrv) GetUserIdsByUsername(ctx context.Context, req *userPb.GetUserIdsByUsernameRequest, resp *userPb.GetUserIdsByUsernameResponse) error {
+	_, span := tracing.Tracer.Start(ctx, "GetUserIdsByUsername")
+	defer span.End()
+	logging.SetSpanWithHostname(span)
+	logger := logging.LogServiceWithTrace(span, "UserService.GetUserIdsByUsername")
+
+	userIds, err := mysql.GetUserIdsByUsername(req.Usernames)
+	if err != nil {
+		logger.Error("get user ids by username error",
+			zap.Error(err),
+			zap.Strings("usernames", req.Usernames))
+		logging.SetSpanError(span, err)
+		return str.ErrUserError
+	}
+	resp.UserIds = userIds
+	return nil
+}
+
 // LoginPassword 用密码的方式登录
 func (u *UserSrv) LoginPassword(ctx context.Context, req *userPb.LSRequest, resp *userPb.LoginResponse) (err error) {
 	ctx, span := tracing.Tracer.Start(ctx, "LoginPasswordService")
@@ -234,20 +252,14 @@ func (u *UserSrv) LoginPassword(ctx context.Context, req *userPb.LSRequest, resp
 		logging.SetSpanError(span, err)
 		return str.ErrLoginError
 	}
-	accessToken, refreshToken, err := jwt.GetToken(user)
+	resp.Token, err = issueSession(ctx, user.UserId, req.Device, req.Ip)
 	if err != nil {
 		logger.Error("get  token  error",
 			zap.Error(err),
-			zap.String("user", req.User),
-			zap.String("password", req.Password))
+			zap.String("user", req.User))
 		logging.SetSpanError(span, err)
 		return str.ErrLoginError
 	}
-
-	resp.Token = &userPb.LoginResponse_Token{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-	}
 	userInfoResp := new(userPb.GetUserInfoResponse)
 	err = u.GetUserInfo(ctx, &userPb.GetUserInfoRequest{
 		UserId: user.UserId,
@@ -411,7 +423,7 @@ func (u *UserSrv) LoginCaptcha(ctx context.Context, req *userPb.LSRequest, resp
 		return str.ErrLoginError
 	}
 	user.UserId = check.UserId
-	accessToken, refreshToken, err := jwt.GetToken(user)
+	resp.Token, err = issueSession(ctx, user.UserId, req.Device, req.Ip)
 	if err != nil {
 		logger.Error("get token error",
 			zap.Error(err),
@@ -419,10 +431,6 @@ func (u *UserSrv) LoginCaptcha(ctx context.Context, req *userPb.LSRequest, resp
 		logging.SetSpanError(span, err)
 		return str.ErrLoginError
 	}
-	resp.Token = &userPb.LoginResponse_Token{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-	}
 
 	return
 }
@@ -2,54 +2,114 @@ package file
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/google/uuid"
-	"github.com/qiniu/go-sdk/v7/auth/qbox"
-	"github.com/qiniu/go-sdk/v7/storage"
 	"go.uber.org/zap"
+	"io"
 	"mime/multipart"
+	"path"
 	"star/app/constant/settings"
 	"star/app/constant/str"
 	"strings"
 	"time"
 )
 
-// UploadToQiNiu 封装上传图片或文件到七牛云然后返回状态和url
-func UploadToQiNiu(ctx context.Context, dir string, uploadMark string, file *multipart.FileHeader, logger *zap.Logger) (string, error) {
-	var AccessKey = settings.Conf.AccessKey
-	var SecretKey = settings.Conf.SecretKey
-	var Bucket = settings.Conf.Bucket
-	var ImgUrl = settings.Conf.QiniuServer
-	putPlicy := storage.PutPolicy{
-		Scope: Bucket,
+const (
+	BackendQiniu = "qiniu"
+	BackendLocal = "local"
+	BackendS3    = "s3"
+)
+
+// ErrNotExist 文件不存在
+var ErrNotExist = errors.New("file not exist")
+
+// FileInfo 文件信息
+type FileInfo struct {
+	Key         string
+	Size        int64
+	ContentType string
+	ModTime     time.Time
+}
+
+// Storage 对象存储
+type Storage interface {
+	// Put 上传文件并返回访问链接
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (string, error)
+	// Delete 删除文件
+	Delete(ctx context.Context, key string) error
+	// SignedURL 生成有效期为expire的私有访问链接
+	SignedURL(ctx context.Context, key string, expire time.Duration) (string, error)
+	// Stat 获取文件信息，文件不存在时返回ErrNotExist
+	Stat(ctx context.Context, key string) (*FileInfo, error)
+}
+
+// Default 根据配置选择的存储，由Init创建
+var Default Storage
+
+// 公开访问的目录，其余目录的文件只能通过SignedURL生成的链接访问
+var publicPrefixes = []string{str.DirImg, str.DirVideo}
+
+// Init 根据配置创建默认存储，使用存储前调用
+func Init() error {
+	storage, err := New(settings.Conf.StorageConfig, settings.Conf.QiniuConfig)
+	if err != nil {
+		return err
 	}
+	Default = storage
+	return nil
+}
+
+// IsPublicKey 文件是否在公开访问的目录下
+func IsPublicKey(key string) bool {
+	key = strings.TrimLeft(path.Clean("/"+key), "/")
+	for _, prefix := range publicPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// New 根据配置创建存储，未配置时使用七牛云
+func New(conf *settings.StorageConfig, qiniuConf *settings.QiniuConfig) (Storage, error) {
+	backend := BackendQiniu
+	if conf != nil && conf.Backend != "" {
+		backend = conf.Backend
+	}
+	switch backend {
+	case BackendQiniu:
+		if qiniuConf == nil {
+			qiniuConf = new(settings.QiniuConfig)
+		}
+		return NewQiniuStorage(qiniuConf), nil
+	case BackendLocal:
+		return NewLocalStorage(conf.LocalDir, conf.LocalBaseUrl, conf.LocalSignKey)
+	case BackendS3:
+		return NewS3Storage(conf)
+	default:
+		return nil, fmt.Errorf("unknown storage backend: %s", backend)
+	}
+}
+
+// Upload 封装上传图片或文件到配置的存储然后返回url
+func Upload(ctx context.Context, dir string, uploadMark string, file *multipart.FileHeader, logger *zap.Logger) (string, error) {
 	src, err := file.Open()
 	if err != nil {
 		return str.Empty, err
 	}
 	defer src.Close()
-	mac := qbox.NewMac(AccessKey, SecretKey)
-	upToken := putPlicy.UploadToken(mac)
-	cfg := storage.Config{
-		Zone:          &storage.ZoneHuadong,
-		UseCdnDomains: false,
-		UseHTTPS:      false,
-	}
-	putExtra := storage.PutExtra{}
-	formUploader := storage.NewFormUploader(&cfg)
-	ret := storage.PutRet{}
 	//生成新的文件名
-	suffix := strings.Split(file.Filename, ".")[1]
-	fileName := uuid.New().String() + uploadMark + str.Dot + suffix
+	fileName := uuid.New().String() + uploadMark + path.Ext(file.Filename)
 	//生成完整上传路径
 	fullDir := dir + time.Now().Format(str.DirTimeParse) + str.Backslashes
 	key := fullDir + fileName
 	//上传
-	err = formUploader.Put(ctx, &ret, upToken, key, src, file.Size, &putExtra)
+	url, err := Default.Put(ctx, key, src, file.Size, file.Header.Get("Content-Type"))
 	if err != nil {
-		logger.Error("upload image error:",
+		logger.Error("upload file error:",
 			zap.Error(err))
 		return "", str.ErrUpload
 	}
-	url := ImgUrl + "/" + ret.Key
 	return url, nil
 }
@@ -0,0 +1,339 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"star/app/constant/str"
+	"time"
+)
+
+const (
+	maxImageSize   = 10 << 20 //原图最大10M
+	maxImagePixels = 50e6     //原图最多5000万像素，防止解压炸弹
+	jpegQuality    = 90
+
+	VariantOrigin = "origin"
+)
+
+// 图片用途，决定生成的缩略图规格
+const (
+	ImagePost               = "post"
+	ImageAvatar             = "avatar"
+	ImageCommunity          = "community"
+	ImageCategoryIcon       = "category_icon"
+	ImageCategoryBackground = "category_background"
+)
+
+// Thumbnail 缩略图规格，Height为0时按宽度等比缩放，否则居中裁剪为固定尺寸
+type Thumbnail struct {
+	Name   string
+	Width  int
+	Height int
+}
+
+var imageThumbnails = map[string][]Thumbnail{
+	ImagePost: {
+		{Name: "medium", Width: 480},
+	},
+	ImageAvatar: {
+		{Name: "small", Width: 64, Height: 64},
+		{Name: "medium", Width: 200, Height: 200},
+	},
+	ImageCommunity: {
+		{Name: "small", Width: 100, Height: 100},
+		{Name: "medium", Width: 400, Height: 400},
+	},
+	ImageCategoryIcon: {
+		{Name: "small", Width: 64, Height: 64},
+	},
+	ImageCategoryBackground: {
+		{Name: "medium", Width: 750, Height: 300},
+	},
+}
+
+// IsImageUsage 判断图片用途是否合法
+func IsImageUsage(usage string) bool {
+	_, ok := imageThumbnails[usage]
+	return ok
+}
+
+var imageExt = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// encodedImage 处理后待上传的图片
+type encodedImage struct {
+	data        []byte
+	ext         string
+	contentType string
+}
+
+// UploadImage 校验并处理图片后上传原图和缩略图，返回各规格的url，原图为VariantOrigin
+func UploadImage(ctx context.Context, dir string, uploadMark string, usage string, file *multipart.FileHeader, logger *zap.Logger) (map[string]string, error) {
+	if file.Size > maxImageSize {
+		return nil, str.ErrImageTooLarge
+	}
+	src, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+	data, err := io.ReadAll(io.LimitReader(src, maxImageSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxImageSize {
+		return nil, str.ErrImageTooLarge
+	}
+	origin, thumbnails, err := processImage(data, usage)
+	if err != nil {
+		if !errors.Is(err, str.ErrInvalidImage) && !errors.Is(err, str.ErrImageTooLarge) {
+			logger.Error("process image error",
+				zap.Error(err),
+				zap.String("filename", file.Filename))
+			return nil, str.ErrInvalidImage
+		}
+		return nil, err
+	}
+	//生成完整上传路径，缩略图与原图同名并带上规格后缀
+	base := dir + time.Now().Format(str.DirTimeParse) + str.Backslashes + uuid.New().String() + uploadMark
+	urls := make(map[string]string, len(thumbnails)+1)
+	url, err := Default.Put(ctx, base+origin.ext, bytes.NewReader(origin.data), int64(len(origin.data)), origin.contentType)
+	if err != nil {
+		logger.Error("upload image error:",
+			zap.Error(err))
+		return nil, str.ErrUpload
+	}
+	urls[VariantOrigin] = url
+	for name, thumbnail := range thumbnails {
+		url, err := Default.Put(ctx, base+"_"+name+thumbnail.ext, bytes.NewReader(thumbnail.data), int64(len(thumbnail.data)), thumbnail.contentType)
+		if err != nil {
+			logger.Error("upload thumbnail error:",
+				zap.Error(err),
+				zap.String("variant", name))
+			return nil, str.ErrUpload
+		}
+		urls[name] = url
+	}
+	return urls, nil
+}
+
+// processImage 根据文件内容识别格式，重新编码原图以去除EXIF等元数据，并生成用途对应的缩略图
+func processImage(data []byte, usage string) (*encodedImage, map[string]*encodedImage, error) {
+	contentType := http.DetectContentType(data)
+	ext, ok := imageExt[contentType]
+	if !ok {
+		return nil, nil, str.ErrInvalidImage
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, nil, str.ErrInvalidImage
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, nil, str.ErrInvalidImage
+	}
+	if config.Width*config.Height > maxImagePixels {
+		return nil, nil, str.ErrImageTooLarge
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var origin *encodedImage
+	switch contentType {
+	case "image/gif":
+		//逐帧重新编码以保留动图，同时去除注释和应用扩展中的元数据
+		origin, err = encodeGIF(data, ext)
+	case "image/jpeg":
+		//去除EXIF前先按拍摄方向旋转，否则图片会显示为横置或倒置
+		img = applyOrientation(img, jpegOrientation(data))
+		origin, err = encodeImage(img, false)
+	case "image/png":
+		origin, err = encodeImage(img, true)
+	default:
+		origin, err = encodeImage(img, !isOpaque(img))
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	thumbnails := make(map[string]*encodedImage)
+	for _, thumbnail := range imageThumbnails[usage] {
+		resized := resizeImage(img, thumbnail)
+		encoded, err := encodeImage(resized, !isOpaque(resized))
+		if err != nil {
+			return nil, nil, err
+		}
+		thumbnails[thumbnail.Name] = encoded
+	}
+	return origin, thumbnails, nil
+}
+
+// encodeGIF 重新编码gif的所有帧，只保留帧、调色板和循环次数
+func encodeGIF(data []byte, ext string) (*encodedImage, error) {
+	g, err := gif.DecodeAll(bytes.NewReader(data))
+	if err != nil {
+		return nil, str.ErrInvalidImage
+	}
+	buf := new(bytes.Buffer)
+	if err := gif.EncodeAll(buf, g); err != nil {
+		return nil, err
+	}
+	return &encodedImage{data: buf.Bytes(), ext: ext, contentType: "image/gif"}, nil
+}
+
+// encodeImage 可能含透明通道的图片编码为png，否则编码为jpeg
+func encodeImage(img image.Image, alpha bool) (*encodedImage, error) {
+	buf := new(bytes.Buffer)
+	if alpha {
+		if err := png.Encode(buf, img); err != nil {
+			return nil, err
+		}
+		return &encodedImage{data: buf.Bytes(), ext: ".png", contentType: "image/png"}, nil
+	}
+	if err := jpeg.Encode(buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, err
+	}
+	return &encodedImage{data: buf.Bytes(), ext: ".jpg", contentType: "image/jpeg"}, nil
+}
+
+func isOpaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	return false
+}
+
+// resizeImage 按规格缩放图片，固定尺寸时先居中裁剪为目标比例，不放大比目标小的图片，宽高至少为1
+func resizeImage(img image.Image, thumbnail Thumbnail) image.Image {
+	bounds := img.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	srcRect := bounds
+	dstW, dstH := thumbnail.Width, thumbnail.Height
+	if dstH == 0 {
+		if srcW <= dstW {
+			dstW, dstH = srcW, srcH
+		} else {
+			dstH = max(srcH*dstW/srcW, 1)
+		}
+	} else {
+		if srcW*dstH > srcH*dstW {
+			cropW := max(srcH*dstW/dstH, 1)
+			x := bounds.Min.X + (srcW-cropW)/2
+			srcRect = image.Rect(x, bounds.Min.Y, x+cropW, bounds.Max.Y)
+		} else {
+			cropH := max(srcW*dstH/dstW, 1)
+			y := bounds.Min.Y + (srcH-cropH)/2
+			srcRect = image.Rect(bounds.Min.X, y, bounds.Max.X, y+cropH)
+		}
+		if srcRect.Dx() < dstW {
+			dstW, dstH = srcRect.Dx(), srcRect.Dy()
+		}
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, dstW, dstH))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, srcRect, draw.Src, nil)
+	return dst
+}
+
+// jpegOrientation 读取jpeg的EXIF方向标记，没有或解析失败时返回1
+func jpegOrientation(data []byte) int {
+	//跳过SOI，逐个遍历段直到找到APP1中的Exif
+	for i := 2; i+4 <= len(data) && data[i] == 0xFF; {
+		marker := data[i+1]
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if marker == 0xDA || length < 2 || i+2+length > len(data) {
+			break
+		}
+		segment := data[i+4 : i+2+length]
+		if marker == 0xE1 && len(segment) > 14 && string(segment[:6]) == "Exif\x00\x00" {
+			return tiffOrientation(segment[6:])
+		}
+		i += 2 + length
+	}
+	return 1
+}
+
+func tiffOrientation(tiff []byte) int {
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+	offset := int(order.Uint32(tiff[4:]))
+	if offset+2 > len(tiff) {
+		return 1
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			break
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			orientation := int(order.Uint16(tiff[entry+8:]))
+			if orientation < 1 || orientation > 8 {
+				return 1
+			}
+			return orientation
+		}
+	}
+	return 1
+}
+
+// applyOrientation 按EXIF方向标记将图片旋转或翻转为正常方向
+func applyOrientation(img image.Image, orientation int) image.Image {
+	if orientation <= 1 {
+		return img
+	}
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	dstW, dstH := w, h
+	if orientation >= 5 {
+		dstW, dstH = h, w
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, dstW, dstH))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2:
+				dx, dy = w-1-x, y
+			case 3:
+				dx, dy = w-1-x, h-1-y
+			case 4:
+				dx, dy = x, h-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = h-1-y, x
+			case 7:
+				dx, dy = h-1-y, w-1-x
+			case 8:
+				dx, dy = y, w-1-x
+			}
+			dst.Set(dx, dy, img.At(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+	return dst
+}
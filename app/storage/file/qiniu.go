@@ -0,0 +1,82 @@
+package file
+
+import (
+	"context"
+	"github.com/qiniu/go-sdk/v7/auth/qbox"
+	"github.com/qiniu/go-sdk/v7/storage"
+	"io"
+	"star/app/constant/settings"
+	"strings"
+	"time"
+)
+
+// QiniuStorage 七牛云存储
+type QiniuStorage struct {
+	conf   *settings.QiniuConfig
+	mac    *qbox.Mac
+	cfg    *storage.Config
+	bucket *storage.BucketManager
+}
+
+func NewQiniuStorage(conf *settings.QiniuConfig) *QiniuStorage {
+	zone := storage.ZoneHuadong
+	if conf.Zone != "" {
+		if region, ok := storage.GetRegionByID(storage.RegionID(conf.Zone)); ok {
+			zone = region
+		}
+	}
+	cfg := &storage.Config{
+		Zone:          &zone,
+		UseCdnDomains: false,
+		UseHTTPS:      false,
+	}
+	mac := qbox.NewMac(conf.AccessKey, conf.SecretKey)
+	return &QiniuStorage{
+		conf:   conf,
+		mac:    mac,
+		cfg:    cfg,
+		bucket: storage.NewBucketManager(mac, cfg),
+	}
+}
+
+func (q *QiniuStorage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (string, error) {
+	putPolicy := storage.PutPolicy{
+		Scope: q.conf.Bucket,
+	}
+	upToken := putPolicy.UploadToken(q.mac)
+	putExtra := storage.PutExtra{
+		MimeType: contentType,
+	}
+	formUploader := storage.NewFormUploader(q.cfg)
+	ret := storage.PutRet{}
+	if err := formUploader.Put(ctx, &ret, upToken, key, r, size, &putExtra); err != nil {
+		return "", err
+	}
+	return q.conf.QiniuServer + "/" + ret.Key, nil
+}
+
+func (q *QiniuStorage) Delete(ctx context.Context, key string) error {
+	return q.bucket.Delete(q.conf.Bucket, key)
+}
+
+func (q *QiniuStorage) SignedURL(ctx context.Context, key string, expire time.Duration) (string, error) {
+	deadline := time.Now().Add(expire).Unix()
+	return storage.MakePrivateURL(q.mac, q.conf.QiniuServer, key, deadline), nil
+}
+
+func (q *QiniuStorage) Stat(ctx context.Context, key string) (*FileInfo, error) {
+	info, err := q.bucket.Stat(q.conf.Bucket, key)
+	if err != nil {
+		//612 资源不存在
+		if strings.Contains(err.Error(), "no such file or directory") {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+	return &FileInfo{
+		Key:         key,
+		Size:        info.Fsize,
+		ContentType: info.MimeType,
+		ModTime:     time.Unix(0, info.PutTime*100),
+	}, nil
+}
@@ -1,9 +1,38 @@
 package mysql
 
-func AddCollect(postId int64) error {
-	return nil
+import (
+	"github.com/jmoiron/sqlx"
+	"star/app/models"
+	"strings"
+)
+
+const (
+	insertCollectSQL = "insert into userCollect(userId,postId) values %s on duplicate key update deletedAt=null,updatedAt=now()"
+	deleteCollectSQL = "update userCollect set deletedAt=now(),updatedAt=now() where deletedAt is null and (userId,postId) in (%s)"
+	// 补写的记录不覆盖已由favorconsumer写入的收藏和取消收藏
+	backfillCollectSQL = "insert ignore into userCollect(userId,postId,createdAt,updatedAt) values "
+)
+
+// ApplyCollect 批量更新帖子收藏数和用户的收藏记录，返回实际写入的消息数
+func ApplyCollect(deltas []*models.FavorDelta) (int, error) {
+	return applyFavor(deltas, func(tx *sqlx.Tx, batch *models.FavorBatch) error {
+		if err := updateFavorUser(tx, insertCollectSQL, deleteCollectSQL, batch.Users); err != nil {
+			return err
+		}
+		return updateFavorCount(tx, favorTables[models.FavorPostCollect], batch.Counts)
+	})
 }
 
-func DeleteCollect(postId int64) error {
-	return nil
+// BackfillUserCollect 将userCollect之前缓存中的收藏补写到mysql
+func BackfillUserCollect(userId int64, records []*models.LikeRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	args := make([]interface{}, 0, len(records)*4)
+	for _, record := range records {
+		args = append(args, userId, record.SourceId, record.LikeTime, record.LikeTime)
+	}
+	query := backfillCollectSQL + strings.TrimSuffix(strings.Repeat("(?,?,?,?),", len(records)), ",")
+	_, err := Client.Exec(query, args...)
+	return err
 }
@@ -21,13 +21,15 @@ const (
 	insertComment      = "INSERT INTO postComment (commentId, postId, userId, content, beCommentId) VALUES (?, ?, ?, ?, ?)"
 	deleteComment      = "UPDATE postComment SET deletedAt = CURRENT_TIMESTAMP WHERE commentId = ?"
 	queryBeCommentId   = "SELECT beCommentId FROM postComment WHERE commentId = ?"
-	queryReply         = "SELECT commentId FROM postComment WHERE beCommentId = ? AND deletedAt IS NULL"
-	queryCommentsStar  = "SELECT commentId, postId, userId, content, star, reply, beCommentId, createdAt FROM postComment WHERE postId = ? AND deletedAt IS NULL ORDER BY star DESC, createdAt DESC"
-	queryCommentsTime  = "SELECT commentId, postId, userId, content, star, reply, beCommentId, createdAt FROM postComment WHERE postId = ? AND deletedAt IS NULL ORDER BY createdAt DESC, star DESC"
+	queryReply         = "SELECT commentId, postId, userId, content, beCommentId FROM postComment WHERE beCommentId = ? AND deletedAt IS NULL"
+	queryCommentsStar  = "SELECT commentId, postId, userId, content, star, reply, beCommentId, createdAt FROM postComment WHERE postId = ? AND beCommentId = 0 AND deletedAt IS NULL AND (star < ? OR (star = ? AND commentId < ?)) ORDER BY star DESC, commentId DESC LIMIT ?"
+	queryCommentsTime  = "SELECT commentId, postId, userId, content, star, reply, beCommentId, createdAt FROM postComment WHERE postId = ? AND beCommentId = 0 AND deletedAt IS NULL AND commentId < ? ORDER BY commentId DESC LIMIT ?"
+	queryReplies       = "SELECT commentId, postId, userId, content, star, reply, beCommentId, createdAt FROM postComment WHERE beCommentId = ? AND deletedAt IS NULL AND commentId > ? ORDER BY commentId LIMIT ?"
+	queryRepliesBatch  = "SELECT commentId, postId, userId, content, star, reply, beCommentId, createdAt FROM (SELECT commentId, postId, userId, content, star, reply, beCommentId, createdAt, ROW_NUMBER() OVER (PARTITION BY beCommentId ORDER BY commentId) AS replyRank FROM postComment WHERE beCommentId IN (?) AND deletedAt IS NULL) t WHERE replyRank <= ? ORDER BY commentId"
 	starComment        = "UPDATE postComment SET star = star + ? WHERE commentId = ?"
 	queryStar          = "SELECT star FROM postComment WHERE commentId = ? AND deletedAt IS NULL"
 	countCommentSQL    = "SELECT comment_count FROM feed WHERE postId = ?"
-	getCommentInfoSQL  = "SELECT  createdAt, commentId, postId, userId, content, star, reply, beCommentId   FROM  postcomment  WHERE commentId=? AND  deletedAt IS NULL;"
+	getCommentInfoSQL  = "SELECT  createdAt, commentId, postId, userId, content, star, reply, beCommentId   FROM  postComment  WHERE commentId=? AND  deletedAt IS NULL;"
 )
 
 // CheckComment 检查评论是否存在
@@ -145,6 +147,7 @@ func CreateComment(comment *models.Comment) error {
 	if err != nil {
 		return err
 	}
+	comment.CommentId = commentId
 
 	// 更新帖子评论数(+1)
 	err = UpdatePostComment(tx, comment.PostId, 1)
@@ -163,25 +166,21 @@ func CreateComment(comment *models.Comment) error {
 	return err
 }
 
-// DeleteComment 删除评论入口
-func DeleteComment(commentId int64) error {
+// DeleteComment 删除评论入口，返回一并删除的所有回复，用于撤回这些回复的提醒
+func DeleteComment(commentId int64) (replies []*models.Comment, err error) {
 	// 开始事务
 	tx, err := Client.Beginx()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer func() {
 		if err != nil {
 			// 如果发生错误，则回滚事务
-			if err = tx.Rollback(); err != nil {
-				return
-			}
-		} else {
-			// 如果没有错误，则提交事务
-			if err = tx.Commit(); err != nil {
-				return
-			}
+			tx.Rollback()
+			return
 		}
+		// 如果没有错误，则提交事务
+		err = tx.Commit()
 	}()
 
 	// 查询对应的postId
@@ -190,21 +189,21 @@ func DeleteComment(commentId int64) error {
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			// 评论不存在或已被删除
-			return fmt.Errorf("评论ID: %d 不存在或已被删除", commentId)
+			return nil, fmt.Errorf("评论ID: %d 不存在或已被删除", commentId)
 		}
-		return err
+		return nil, err
 	}
 
 	// 递归删除评论
-	if err := deleteComments(tx, commentId, postId); err != nil {
-		return err
+	if err = deleteComments(tx, commentId, postId, &replies); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return replies, nil
 }
 
-// 使用递归删除评论
-func deleteComments(tx *sqlx.Tx, commentId int64, postId int64) error {
+// 使用递归删除评论，被删除的回复追加到replies
+func deleteComments(tx *sqlx.Tx, commentId int64, postId int64, replies *[]*models.Comment) error {
 	// 检查评论是否存在
 	if err := CheckComment(commentId); err != nil {
 		return err
@@ -237,31 +236,15 @@ func deleteComments(tx *sqlx.Tx, commentId int64, postId int64) error {
 	}
 
 	// 查找所有以当前commentId为beCommentId的评论（即直接回复）
-	rows, err := tx.Query(queryReply, commentId)
-	if err != nil {
-		return err
-	}
-	defer func() {
-		if err := rows.Close(); err != nil {
-			return
-		}
-	}()
-
-	var childIds []int64
-	for rows.Next() {
-		var childId int64
-		if err := rows.Scan(&childId); err != nil {
-			return err
-		}
-		childIds = append(childIds, childId)
-	}
-	if err := rows.Err(); err != nil {
+	var children []*models.Comment
+	if err := tx.Select(&children, queryReply, commentId); err != nil {
 		return err
 	}
 
 	// 递归删除所有子评论
-	for _, childId := range childIds {
-		if err := deleteComments(tx, childId, postId); err != nil {
+	for _, child := range children {
+		*replies = append(*replies, child)
+		if err := deleteComments(tx, child.CommentId, postId, replies); err != nil {
 			return err
 		}
 	}
@@ -269,54 +252,53 @@ func deleteComments(tx *sqlx.Tx, commentId int64, postId int64) error {
 	return nil
 }
 
-// GetCommentsStar 获取评论（按照点赞数排序）
-func GetCommentsStar(postId int64) ([]*models.Comment, error) {
-
-	// 执行SQL查询，包含分页和软删除检查
-	rows, err := Client.Query(queryCommentsStar, postId)
-	if err != nil {
-		// 如果查询失败，返回错误。
-		return nil, fmt.Errorf("查询数据库错误，err:%v", err)
+// GetCommentsStar 按点赞数倒序分页获取帖子的顶级评论，点赞数相同时按评论id倒序
+// 首页lastStar传math.MaxInt64
+func GetCommentsStar(postId int64, lastStar int64, lastCommentId int64, limit int) ([]*models.Comment, error) {
+	var comments []*models.Comment
+	if err := Client.Select(&comments, queryCommentsStar, postId, lastStar, lastStar, lastCommentId, limit); err != nil {
+		return nil, err
 	}
+	return comments, nil
+}
 
-	// 延迟关闭rows，确保在函数退出前释放数据库连接
-	defer func() {
-		if err := rows.Close(); err != nil {
-			return
-		}
-	}()
-
-	// 初始化一个空的切片来存储查询到的评论
+// GetCommentsTime 按时间倒序分页获取帖子的顶级评论，评论id由雪花算法生成，按id排序即按时间排序
+// 首页lastCommentId传math.MaxInt64
+func GetCommentsTime(postId int64, lastCommentId int64, limit int) ([]*models.Comment, error) {
 	var comments []*models.Comment
-
-	// 遍历查询结果
-	for rows.Next() {
-		// 创建一个Comment结构体实例来存储当前行的数据
-		var comment models.Comment
-
-		// 将当前行的数据扫描到comment实例中
-		if err := rows.Scan(&comment.CommentId, &comment.PostId, &comment.UserId, &comment.Content, &comment.Star, &comment.Reply, &comment.BeCommentId, &comment.CreatedAt); err != nil {
-			// 如果扫描失败，返回错误
-			return nil, fmt.Errorf("调用Scan错误，err:%v", err)
-		}
-
-		// 将comment实例的指针添加到comments切片中
-		comments = append(comments, &comment)
+	if err := Client.Select(&comments, queryCommentsTime, postId, lastCommentId, limit); err != nil {
+		return nil, err
 	}
+	return comments, nil
+}
 
-	// 检查是否有在迭代过程中发生的错误
-	if err := rows.Err(); err != nil {
-		// 如果有错误，返回错误
-		return nil, fmt.Errorf("迭代出错，err:%v", err)
+// GetReplies 按时间正序分页获取评论的直接回复
+func GetReplies(commentId int64, lastCommentId int64, limit int) ([]*models.Comment, error) {
+	var replies []*models.Comment
+	if err := Client.Select(&replies, queryReplies, commentId, lastCommentId, limit); err != nil {
+		return nil, err
 	}
+	return replies, nil
+}
 
-	//if len(comments) == 0 {
-	//	// 如果没有找到任何有效评论，返回错误
-	//	return nil, fmt.Errorf("暂无评论")
-	//}
-
-	// 返回查询到的评论列表，如果为空，返回空评论
-	return comments, nil
+// GetRepliesBatch 一次获取多条评论各自最早的limit条直接回复，按被回复的评论id分组
+func GetRepliesBatch(commentIds []int64, limit int) (map[int64][]*models.Comment, error) {
+	if len(commentIds) == 0 {
+		return nil, nil
+	}
+	query, args, err := sqlx.In(queryRepliesBatch, commentIds, limit)
+	if err != nil {
+		return nil, err
+	}
+	var replies []*models.Comment
+	if err := Client.Select(&replies, Client.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+	grouped := make(map[int64][]*models.Comment, len(commentIds))
+	for _, reply := range replies {
+		grouped[reply.BeCommentId] = append(grouped[reply.BeCommentId], reply)
+	}
+	return grouped, nil
 }
 
 func CountComment(postId int64) (string, error) {
@@ -18,6 +18,7 @@ const (
 	queryCommunityListSQL        = "select communityId,communityName,Avatar from community "
 	getCommunityInfoSQL          = "select communityId, description, communityName, member, leaderId, manageId,img from community  where communityId=?"
 	getAllCommunityIdSQL         = "select communityId from community"
+	checkCommunityExistSQL       = "select count(1) from community where communityId=? and deletedAt is null"
 	countCommunityFollowSQL      = "select count(1) from community_follows where userId=?"
 	isFollowCommunitySQL         = "select count(1) from community_follows where userId=? and communityId=? and  deletedAt IS NULL"
 	getCommunityFollowIdSQL      = "select  communityId from community_follows where userId=? and deletedAt IS NULL"
@@ -46,8 +47,21 @@ func QueryCommunityList() error {
 	return nil
 }
 
-func InsertCommunity(community *models.Community) error {
-	if _, err := Client.Exec(insertCommunitySQL,
+// InsertCommunity 创建社区，并在同一个事务中授予创建者社区主持角色
+func InsertCommunity(community *models.Community) (err error) {
+	tx, err := Client.Beginx()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			tx.Rollback()
+		}
+	}()
+	if _, err = tx.Exec(insertCommunitySQL,
 		community.CommunityId,
 		community.CommunityName,
 		community.Description,
@@ -57,7 +71,10 @@ func InsertCommunity(community *models.Community) error {
 	); err != nil {
 		return err
 	}
-	return nil
+	if _, err = tx.Exec(grantRoleSQL, community.LeaderId, models.RoleCommunityLeader, community.CommunityId, community.LeaderId); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 func GetCommunityInfo(communityId int64) (*models.Community, error) {
@@ -68,6 +85,18 @@ func GetCommunityInfo(communityId int64) (*models.Community, error) {
 	return community, nil
 }
 
+// CheckCommunityExist 社区不存在时返回str.ErrCommunityNotExists
+func CheckCommunityExist(communityId int64) error {
+	var count int64
+	if err := Client.Get(&count, checkCommunityExistSQL, communityId); err != nil {
+		return err
+	}
+	if count == 0 {
+		return str.ErrCommunityNotExists
+	}
+	return nil
+}
+
 func GetAllCommunityId() ([]int64, error) {
 	var commnutyIds []int64
 	if err := Client.Select(&commnutyIds, getAllCommunityIdSQL); err != nil {
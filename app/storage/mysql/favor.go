@@ -0,0 +1,269 @@
+package mysql
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"sort"
+	"star/app/models"
+	"strings"
+	"time"
+)
+
+const (
+	queryFavorMessageSQL  = "select messageId from favor_message where messageId in (?)"
+	insertFavorMessageSQL = "insert into favor_message(messageId) values "
+	purgeFavorMessageSQL  = "delete from favor_message where createdAt<? limit ?"
+	// 单条语句更新多行的计数：update post set star=star+case postId when ? then ? ... end where postId in (?,...)
+	updateFavorCountSQL = "update %s set %s=%s+case %s %s end where %s in (%s)"
+	// 计数与读取时一致才写入：update post set star=case postId when ? then ? ... end where (postId,star) in ((?,?),...)
+	setFavorCountSQL     = "update %s set %s=case %s %s end where (%s,%s) in (%s)"
+	getFavorCountSQL     = "select %s as id,%s as count from %s where %s in (?)"
+	listFavorSourceIdSQL = "select %s from %s where %s>? order by %s limit ?"
+)
+
+// favorTable 计数所在的表，countSQL从点赞、收藏记录统计计数
+type favorTable struct {
+	table    string
+	column   string
+	key      string
+	countSQL string
+}
+
+var favorTables = map[string]favorTable{
+	models.FavorPostLike: {"post", "star", "postId",
+		"select sourceId as id,count(1) as count from userLike where sourceType=1 and deletedAt is null and sourceId in (?) group by sourceId"},
+	models.FavorCommentLike: {"postComment", "star", "commentId",
+		"select sourceId as id,count(1) as count from userLike where sourceType=2 and deletedAt is null and sourceId in (?) group by sourceId"},
+	models.FavorPostCollect: {"post", "collection", "postId",
+		"select postId as id,count(1) as count from userCollect where deletedAt is null and postId in (?) group by postId"},
+}
+
+type favorCount struct {
+	Id    int64 `db:"id"`
+	Count int64 `db:"count"`
+}
+
+// CountFavorRelation 从点赞、收藏记录统计计数，没有记录的id不返回
+func CountFavorRelation(kind string, ids []int64) (map[int64]int64, error) {
+	return selectFavorCount(favorTables[kind].countSQL, ids)
+}
+
+// GetFavorCount 获取数据库中的计数，帖子或评论不存在的id不返回
+func GetFavorCount(kind string, ids []int64) (map[int64]int64, error) {
+	t := favorTables[kind]
+	return selectFavorCount(fmt.Sprintf(getFavorCountSQL, t.key, t.column, t.table, t.key), ids)
+}
+
+func selectFavorCount(query string, ids []int64) (map[int64]int64, error) {
+	counts := make(map[int64]int64, len(ids))
+	if len(ids) == 0 {
+		return counts, nil
+	}
+	query, args, err := sqlx.In(query, ids)
+	if err != nil {
+		return nil, err
+	}
+	var rows []*favorCount
+	if err := Client.Select(&rows, Client.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.Id] = row.Count
+	}
+	return counts, nil
+}
+
+// SetFavorCount 修复数据库中的计数，只修改计数与observed一致的行，返回修复的数量
+func SetFavorCount(kind string, observed map[int64]int64, target map[int64]int64) (int64, error) {
+	if len(target) == 0 {
+		return 0, nil
+	}
+	t := favorTables[kind]
+	ids := make([]int64, 0, len(target))
+	for id := range target {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	args := make([]interface{}, 0, len(ids)*4)
+	for _, id := range ids {
+		args = append(args, id, target[id])
+	}
+	for _, id := range ids {
+		args = append(args, id, observed[id])
+	}
+	query := fmt.Sprintf(setFavorCountSQL, t.table, t.column, t.key,
+		strings.TrimSuffix(strings.Repeat("when ? then ? ", len(ids)), " "),
+		t.key, t.column, strings.TrimSuffix(strings.Repeat("(?,?),", len(ids)), ","))
+	result, err := Client.Exec(query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// ListFavorSourceId 按id顺序获取cursor之后的帖子或评论id，用于全量对账
+func ListFavorSourceId(kind string, cursor int64, limit int) ([]int64, error) {
+	t := favorTables[kind]
+	var ids []int64
+	if err := Client.Select(&ids, fmt.Sprintf(listFavorSourceIdSQL, t.key, t.table, t.key, t.key), cursor, limit); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// PurgeFavorMessage 删除before之前写入的消息id，每次最多删除limit条
+// 消息只会在确认前被重新投递，去重记录只需要保留到消息被确认之后
+func PurgeFavorMessage(before time.Time, limit int) (int64, error) {
+	result, err := Client.Exec(purgeFavorMessageSQL, before, limit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// applyFavor 在一个事务中记录消息id并写入合并后的计数变化，已写入过的消息被跳过，返回实际写入的消息数
+// 写入数据库后、确认消息前崩溃会导致消息被重新投递，消息id保证重新投递的消息不会被重复计数
+func applyFavor(deltas []*models.FavorDelta, apply func(tx *sqlx.Tx, batch *models.FavorBatch) error) (applied int, err error) {
+	var tx *sqlx.Tx
+	tx, err = Client.Beginx()
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			tx.Rollback()
+		}
+	}()
+	if deltas, err = filterAppliedFavor(tx, deltas); err != nil {
+		return 0, err
+	}
+	if len(deltas) == 0 {
+		return 0, tx.Commit()
+	}
+	if err = insertFavorMessage(tx, deltas); err != nil {
+		return 0, err
+	}
+	if err = apply(tx, models.MergeFavorDeltas(deltas)); err != nil {
+		return 0, err
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(deltas), nil
+}
+
+// filterAppliedFavor 过滤掉已经写入过的消息和同一批次中重复的消息
+func filterAppliedFavor(tx *sqlx.Tx, deltas []*models.FavorDelta) ([]*models.FavorDelta, error) {
+	ids := make([]int64, 0, len(deltas))
+	for _, delta := range deltas {
+		if delta.MessageId != 0 {
+			ids = append(ids, delta.MessageId)
+		}
+	}
+	seen := make(map[int64]struct{}, len(ids))
+	if len(ids) > 0 {
+		query, args, err := sqlx.In(queryFavorMessageSQL, ids)
+		if err != nil {
+			return nil, err
+		}
+		var applied []int64
+		if err := tx.Select(&applied, tx.Rebind(query), args...); err != nil {
+			return nil, err
+		}
+		for _, id := range applied {
+			seen[id] = struct{}{}
+		}
+	}
+	filtered := make([]*models.FavorDelta, 0, len(deltas))
+	for _, delta := range deltas {
+		if delta.MessageId != 0 {
+			if _, ok := seen[delta.MessageId]; ok {
+				continue
+			}
+			seen[delta.MessageId] = struct{}{}
+		}
+		filtered = append(filtered, delta)
+	}
+	return filtered, nil
+}
+
+// insertFavorMessage 记录本批次写入的消息id，并发写入同一条消息时主键冲突会使事务回滚
+func insertFavorMessage(tx *sqlx.Tx, deltas []*models.FavorDelta) error {
+	args := make([]interface{}, 0, len(deltas))
+	for _, delta := range deltas {
+		if delta.MessageId != 0 {
+			args = append(args, delta.MessageId)
+		}
+	}
+	if len(args) == 0 {
+		return nil
+	}
+	query := insertFavorMessageSQL + strings.TrimSuffix(strings.Repeat("(?),", len(args)), ",")
+	_, err := tx.Exec(query, args...)
+	return err
+}
+
+// updateFavorCount 用一条语句更新多行的计数，按id排序加锁，避免并发更新时死锁
+func updateFavorCount(tx *sqlx.Tx, t favorTable, counts map[int64]int64) error {
+	if len(counts) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(counts))
+	for id := range counts {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	args := make([]interface{}, 0, len(ids)*3)
+	for _, id := range ids {
+		args = append(args, id, counts[id])
+	}
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	query := fmt.Sprintf(updateFavorCountSQL, t.table, t.column, t.column, t.key,
+		strings.TrimSuffix(strings.Repeat("when ? then ? ", len(ids)), " "),
+		t.key, strings.TrimSuffix(strings.Repeat("?,", len(ids)), ","))
+	_, err := tx.Exec(query, args...)
+	return err
+}
+
+// updateFavorUser 用一条语句写入多个用户的点赞、收藏记录，净变化大于0的写入，小于0的删除
+// prefix为每行记录前面的固定列，如点赞记录的sourceType
+func updateFavorUser(tx *sqlx.Tx, insertSQL, deleteSQL string, users map[models.FavorUser]int64, prefix ...interface{}) error {
+	var added, deleted []models.FavorUser
+	for user, value := range users {
+		if value > 0 {
+			added = append(added, user)
+		} else {
+			deleted = append(deleted, user)
+		}
+	}
+	if err := execFavorUser(tx, insertSQL, added, prefix); err != nil {
+		return err
+	}
+	return execFavorUser(tx, deleteSQL, deleted, prefix)
+}
+
+func execFavorUser(tx *sqlx.Tx, query string, users []models.FavorUser, prefix []interface{}) error {
+	if len(users) == 0 {
+		return nil
+	}
+	sort.Slice(users, func(i, j int) bool {
+		if users[i].UserId != users[j].UserId {
+			return users[i].UserId < users[j].UserId
+		}
+		return users[i].SourceId < users[j].SourceId
+	})
+	args := make([]interface{}, 0, len(users)*(len(prefix)+2))
+	for _, user := range users {
+		args = append(args, prefix...)
+		args = append(args, user.UserId, user.SourceId)
+	}
+	row := "(" + strings.Repeat("?,", len(prefix)) + "?,?),"
+	values := strings.TrimSuffix(strings.Repeat(row, len(users)), ",")
+	_, err := tx.Exec(fmt.Sprintf(query, values), args...)
+	return err
+}
@@ -3,6 +3,7 @@ package mysql
 import (
 	"database/sql"
 	"errors"
+	"github.com/jmoiron/sqlx"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"star/app/constant/str"
@@ -15,9 +16,10 @@ const (
 	getPostByPopularitySQL          = "select postId, userId,collection,star,content,isScan,communityId from post where isScan=true order by star desc,collection desc limit ?"
 	getCommunityPostByPopularitySQL = "select postId, userId,collection,star,content,isScan,communityId from post where isScan=true and  communityId=?  order by star desc,collection desc limit ?"
 	getPostByTimeSQL                = "select postId, userId,collection,star,content,isScan,communityId from post where isScan=true and postId<? limit ?"
-	queryPostsSQL                   = "select postId, userId,collection,star,content,isScan,communityId from post where postId in (?)"
+	queryPostsSQL                   = "select postId, userId,collection,star,content,video,isScan,communityId from post where postId in (?) and deletedAt is null"
 	getCommunityPostByTimeSQL       = "select postId, userId,collection,star,content,isScan,communityId  from post where isScan=true and communityId=?and postId<? limit ?"
 	getCommunityPostByNewReplySQL   = "select postId,userId,communityId,content from  post where isScan=true and communityId =?  and lastReplyTime <? order by lastReplyTime desc limit ? "
+	getPostByRelationSQL            = "select postId, userId,collection,star,content,isScan,communityId from post where isScan=true and deletedAt is null and userId in (?) and postId<? order by postId desc limit ?"
 )
 
 func QueryPostExist(postId int64) (string, error) {
@@ -61,11 +63,14 @@ func GetPostByTime(postId int64, limit int64) ([]*models.Post, error) {
 
 func QueryPosts(postIds []int64) ([]*models.Post, error) {
 	var posts []*models.Post
-	cpostIds := make([]interface{}, len(postIds))
-	for i, postId := range postIds {
-		cpostIds[i] = postId
+	if len(postIds) == 0 {
+		return posts, nil
+	}
+	query, args, err := sqlx.In(queryPostsSQL, postIds)
+	if err != nil {
+		return nil, err
 	}
-	if err := Client.Select(&posts, queryPostsSQL, cpostIds...); err != nil {
+	if err := Client.Select(&posts, Client.Rebind(query), args...); err != nil {
 		return nil, err
 	}
 	return posts, nil
@@ -86,3 +91,19 @@ func GetCommunityPostByNewReply(communityId int64, lastReplyTime string, limit i
 	}
 	return posts, nil
 }
+
+// GetPostByRelation 按帖子id倒序获取关注用户在lastPostId之前的帖子
+func GetPostByRelation(userIds []int64, lastPostId int64, limit int64) ([]*models.Post, error) {
+	var posts []*models.Post
+	if len(userIds) == 0 {
+		return posts, nil
+	}
+	query, args, err := sqlx.In(getPostByRelationSQL, userIds, lastPostId, limit)
+	if err != nil {
+		return nil, err
+	}
+	if err := Client.Select(&posts, Client.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
@@ -0,0 +1,26 @@
+package mysql
+
+import (
+	"star/app/models"
+)
+
+const (
+	//消息可能被重复投递，按chatId忽略重复插入
+	insertGroupMessageSQL = "insert ignore into group_messages(chatId, sendTime, content, sdUserId, communityId) values (?,?,?,?,?)"
+	loadGroupMessageSQL   = "select chatId, sendTime, content, sdUserId, communityId from group_messages where communityId=? and chatId<? order by chatId desc limit ?"
+)
+
+// InsertGroupMessage 保存群聊消息
+func InsertGroupMessage(message *models.GroupMessage) error {
+	_, err := Client.Exec(insertGroupMessageSQL, message.ChatId, message.SendTime, message.Content, message.SdUserId, message.CommunityId)
+	return err
+}
+
+// LoadGroupMessage 按时间倒序加载社区中chatId小于lastChatId的群聊消息
+func LoadGroupMessage(communityId int64, lastChatId int64, limit int) ([]*models.GroupMessage, error) {
+	var messages []*models.GroupMessage
+	if err := Client.Select(&messages, loadGroupMessageSQL, communityId, lastChatId, limit); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
@@ -1,38 +1,71 @@
 package mysql
 
 import (
-	"database/sql"
-	"errors"
-	"strconv"
+	"github.com/jmoiron/sqlx"
+	"star/app/models"
+	"strings"
 )
 
 const (
-	isLikeCommentSQL     = "select count(1) from like_remind where source_id=? and source_type='comment'and sender_id=?"
-	updatePostLikeSQL    = "update  feed set  star=star+? where postId=? "
-	updateCommentLikeSQL = "update  postComment set  star=star+? where commentId=? "
+	listUserLikeSQL = "select sourceId,updatedAt from userLike where userId=? and sourceType=? and deletedAt is null"
+	insertLikeSQL   = "insert into userLike(sourceType,userId,sourceId) values %s on duplicate key update deletedAt=null,updatedAt=now()"
+	deleteLikeSQL   = "update userLike set deletedAt=now(),updatedAt=now() where deletedAt is null and (sourceType,userId,sourceId) in (%s)"
+	// 补写的记录不覆盖已由favorconsumer写入的点赞和取消点赞
+	backfillLikeSQL = "insert ignore into userLike(sourceType,userId,sourceId,createdAt,updatedAt) values "
+	// 评论点赞在userLike之前只记录在点赞提醒中，同一用户对同一评论只保留一条
+	backfillCommentLikeSQL = `insert ignore into userLike(sourceType,userId,sourceId,createdAt,updatedAt)
+	                          select ?,sender_id,source_id,min(remind_time),max(remind_time) from like_remind
+	                          where source_type='comment' and deletedAt is null group by sender_id,source_id`
 )
 
-func IsLikeComment(actorId, commentId int64) (string, error) {
-	var count int64
-	if err := Client.Get(&count, isLikeCommentSQL, commentId, actorId); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return "0", nil
-		}
-		return "0", err
+// ListUserLike 获取用户点赞的全部帖子或评论，用于缓存丢失后重建
+func ListUserLike(userId int64, sourceType int) ([]*models.LikeRecord, error) {
+	var records []*models.LikeRecord
+	if err := Client.Select(&records, listUserLikeSQL, userId, sourceType); err != nil {
+		return nil, err
 	}
-	countStr := strconv.FormatInt(count, 64)
-	return countStr, nil
+	return records, nil
 }
 
-func UpdatePostLike(postId int64, count int) error {
-	if _, err := Client.Exec(updatePostLikeSQL, count, postId); err != nil {
-		return err
+// BackfillUserLike 将userLike之前缓存中的点赞补写到mysql
+func BackfillUserLike(sourceType int, userId int64, records []*models.LikeRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	args := make([]interface{}, 0, len(records)*5)
+	for _, record := range records {
+		args = append(args, sourceType, userId, record.SourceId, record.LikeTime, record.LikeTime)
 	}
-	return nil
+	query := backfillLikeSQL + strings.TrimSuffix(strings.Repeat("(?,?,?,?,?),", len(records)), ",")
+	_, err := Client.Exec(query, args...)
+	return err
 }
-func UpdateCommentLike(commentId int64, count int) error {
-	if _, err := Client.Exec(updateCommentLikeSQL, count, commentId); err != nil {
-		return err
+
+// BackfillCommentLike 从点赞提醒补写评论点赞记录，返回补写的数量
+func BackfillCommentLike() (int64, error) {
+	result, err := Client.Exec(backfillCommentLikeSQL, models.LikeSourceComment)
+	if err != nil {
+		return 0, err
 	}
-	return nil
+	return result.RowsAffected()
+}
+
+// ApplyPostLike 批量写入用户的帖子点赞记录并更新帖子点赞数，返回实际写入的消息数
+func ApplyPostLike(deltas []*models.FavorDelta) (int, error) {
+	return applyFavor(deltas, func(tx *sqlx.Tx, batch *models.FavorBatch) error {
+		if err := updateFavorUser(tx, insertLikeSQL, deleteLikeSQL, batch.Users, models.LikeSourcePost); err != nil {
+			return err
+		}
+		return updateFavorCount(tx, favorTables[models.FavorPostLike], batch.Counts)
+	})
+}
+
+// ApplyCommentLike 批量写入用户的评论点赞记录并更新评论点赞数，返回实际写入的消息数
+func ApplyCommentLike(deltas []*models.FavorDelta) (int, error) {
+	return applyFavor(deltas, func(tx *sqlx.Tx, batch *models.FavorBatch) error {
+		if err := updateFavorUser(tx, insertLikeSQL, deleteLikeSQL, batch.Users, models.LikeSourceComment); err != nil {
+			return err
+		}
+		return updateFavorCount(tx, favorTables[models.FavorCommentLike], batch.Counts)
+	})
 }
@@ -1,6 +1,8 @@
 package mysql
 
 import (
+	"database/sql"
+	"errors"
 	"github.com/jmoiron/sqlx"
 	"go.uber.org/zap"
 	"star/app/constant/str"
@@ -14,21 +16,21 @@ const (
 	listMessageCountSQL = `
                          select
                          (select count(1) from private_messages  where recipient_id=? and status=false) as privateMsgCount,
-                         (select count(1) from  user_system_notice where recipient_id=? and status=false) as  systemCount,
-                         (select count(1) from like_remind where  recipient_id=? and status=false) as  likeCount,
-                         (select count(1) from mention_remind where  recipient_id=? and status=false) as  mentionCount,
-                         (select count(1) from   reply_remind where  recipient_id=? and status=false) as replyCount;
+                         (select count(1) from  user_system_notice u join manager_system_notice m on u.system_notice_id=m.system_notice_id
+                          where u.recipient_id=? and u.status=false and m.revokedAt is null) as  systemCount,
+                         (select count(1) from like_remind where  recipient_id=? and status=false and deletedAt is null) as  likeCount,
+                         (select count(1) from mention_remind where  recipient_id=? and status=false and deletedAt is null) as  mentionCount,
+                         (select count(1) from   reply_remind where  recipient_id=? and status=false and deletedAt is null) as replyCount;
                           `
-	insertPrivateMsgSQL      = "insert into private_msg(private_message_id,sender_id,recipient_id,content,status,send_time) values (?,?,?,?,?,?)"
-	updatePrivateChatSQL     = "update private_chat set last_message_content=? where user1_id=? and user2_id = ?"
+	insertPrivateMsgSQL      = "insert ignore into private_messages(private_message_id,sender_id,recipient_id,content,status,send_time,private_chat_id) values (?,?,?,?,?,?,?)"
+	updatePrivateChatSQL     = "update private_chat set last_message_content=?, last_message_time=? where user1_id=? and user2_id = ?"
 	checkPrivateChatExistSQL = "select  private_chat_id from private_chat where user1_id=? and user2_id = ?"
-	insertPrivateChatSQL     = "insert into  private_chat(private_chat_id,user1_id,user2_id,last_message_content, last_message_time)values (?,?,?,?)"
-	insertSystemMsgSQL       = "insert into manager_system_notice(system_notice_id, title, content, type, status, recipient_id, manager_id, publish_time)values(?,?,?,?,?,?,?,?)"
-	insertSystemMsgUserSQL   = "insert into user_system_notice(user_notice_id,system_notice_id, recipient_id,status)values(?,?,?,?)"
-	queryAllUserIdSQL        = "select userId from user"
-	insertLikeMessageSQL     = "insert into like_remind(id, source_id, source_type, content, url, status, sender_id, recipient_id, remind_time)values(?,?,?,?,?,?,?,?,?)"
-	insertMentionMessageSQL  = "insert into mention_remind(id, source_id, source_type, content, url, status, sender_id, recipient_id, remind_time)values(?,?,?,?,?,?,?,?,?)"
-	insertReplyMessageSQL    = "insert into reply_remind(id, source_id, source_type, content, url, status, sender_id, recipient_id, remind_time)values(?,?,?,?,?,?,?,?,?)"
+	insertPrivateChatSQL     = "insert into  private_chat(private_chat_id,user1_id,user2_id,last_message_content, last_message_time)values (?,?,?,?,?)"
+	insertSystemMsgSQL       = "insert ignore into manager_system_notice(system_notice_id, title, content, type, status, recipient_id, target_id, manager_id, publish_time, insertedAt)values(?,?,?,?,?,?,?,?,?,?)"
+	insertNoticeRecipientSQL = "insert ignore into system_notice_recipient(system_notice_id, recipient_id) values(:system_notice_id,:recipient_id)"
+	insertLikeMessageSQL     = "insert ignore into like_remind(id, source_id, source_type, content, url, status, sender_id, recipient_id, remind_time)values(?,?,?,?,?,?,?,?,?)"
+	insertMentionMessageSQL  = "insert ignore into mention_remind(id, source_id, source_type, content, url, status, sender_id, recipient_id, remind_time)values(?,?,?,?,?,?,?,?,?)"
+	insertReplyMessageSQL    = "insert ignore into reply_remind(id, source_id, source_type, content, url, status, sender_id, recipient_id, remind_time)values(?,?,?,?,?,?,?,?,?)"
 	loadMessageSQL           = `
               select  private_message_id, sender_id, recipient_id, content, status, send_time, private_chat_id   from private_messages
               where  private_chat_id=? and send_time<? order by send_time desc limit ?;
@@ -52,9 +54,52 @@ const (
       WHERE user2_id = ?
     )   ORDER BY last_message_time DESC
 `
-	getAllPrivateChat     = "select user1_id,user2_id from private_chat"
-	insertBatchSystemUser = "insert into user_system_notice(user_notice_id,system_notice_id, recipient_id,status) values(:user_notice_id,:system_notice_id,:recipient_id,:status)"
-	deleteLikeMessageSQL  = "update like_remind set deletedAt=? where source_id=? and source_type=? and sender_id=? and recipient_id=?"
+	getAllPrivateChat       = "select user1_id,user2_id from private_chat"
+	insertBatchSystemUser   = "insert ignore into user_system_notice(user_notice_id,system_notice_id, recipient_id,status,pull_time) values(:user_notice_id,:system_notice_id,:recipient_id,:status,:pull_time)"
+	deleteLikeMessageSQL    = "update like_remind set deletedAt=? where source_id=? and source_type=? and sender_id=? and recipient_id=? and deletedAt is null"
+	deleteReplyMessageSQL   = "update reply_remind set deletedAt=? where source_id=? and source_type=? and sender_id=? and recipient_id=? and deletedAt is null"
+	deleteMentionMessageSQL = "update mention_remind set deletedAt=? where source_id=? and source_type=? and sender_id=? and recipient_id=? and deletedAt is null"
+	readPrivateMessageSQL   = "update private_messages set status=true where recipient_id=? and sender_id=? and private_message_id<=? and status=false"
+	readAllPrivateMsgSQL    = "update private_messages set status=true where recipient_id=? and status=false"
+	readLikeRemindSQL       = "update like_remind set status=true where recipient_id=? and status=false"
+	readMentionRemindSQL    = "update mention_remind set status=true where recipient_id=? and status=false"
+	readReplyRemindSQL      = "update reply_remind set status=true where recipient_id=? and status=false"
+	readSystemNoticeSQL     = "update user_system_notice set status=true where recipient_id=? and status=false"
+	listLikeRemindSQL       = `
+              select max(id) as id, source_id, source_type, count(distinct sender_id) as sender_count,
+                     min(status) as status, max(remind_time) as remind_time
+              from like_remind where recipient_id=? and deletedAt is null
+              group by source_id, source_type having max(id)<? order by id desc limit ?;
+            `
+	listLikeSenderSQL = `
+              select id, source_id, source_type, sender_id, content, url from (
+                select id, source_id, source_type, sender_id, content, url,
+                       row_number() over (partition by source_id, source_type order by id desc) as sender_rank
+                from like_remind where recipient_id=? and source_id in (?) and deletedAt is null
+              ) t where sender_rank<=? order by id desc;
+            `
+	listReplyRemindSQL = `
+              select id, source_id, source_type, content, url, status, sender_id, recipient_id, remind_time from reply_remind
+              where recipient_id=? and id<? and deletedAt is null order by id desc limit ?;
+            `
+	listMentionRemindSQL = `
+              select id, source_id, source_type, content, url, status, sender_id, recipient_id, remind_time from mention_remind
+              where recipient_id=? and id<? and deletedAt is null order by id desc limit ?;
+            `
+	listSystemNoticeSQL = `
+              select u.user_notice_id, u.status, m.title, m.content, m.publish_time
+              from user_system_notice u join manager_system_notice m on u.system_notice_id=m.system_notice_id
+              where u.recipient_id=? and u.user_notice_id<? and m.revokedAt is null order by u.user_notice_id desc limit ?;
+            `
+	listPendingNoticeSQL = `
+              select system_notice_id, type, recipient_id, target_id, publish_time from manager_system_notice
+              where (insertedAt>? or publish_time>?) and publish_time<=? and revokedAt is null and (type<>'single' or recipient_id=?)
+              order by publish_time;
+            `
+	filterNoticeRecipientSQL = "select system_notice_id from system_notice_recipient where recipient_id=? and system_notice_id in (?)"
+	revokeSystemNoticeSQL    = "update manager_system_notice set revokedAt=? where system_notice_id=? and revokedAt is null"
+	queryUnreadNoticeUserSQL = "select recipient_id from user_system_notice where system_notice_id=? and status=false"
+	deleteUserNoticeSQL      = "delete from user_system_notice where system_notice_id=?"
 )
 
 func ListMessageCount(userId int64) (*models.Counts, error) {
@@ -66,28 +111,29 @@ func ListMessageCount(userId int64) (*models.Counts, error) {
 	return counts, nil
 }
 
-func InsertPrivateMsg(message *models.PrivateMessage) (err error) {
+// InsertPrivateMsg 保存私信并更新会话，私信id已存在时不做修改并返回false，重复投递的消息不会被重复保存
+func InsertPrivateMsg(message *models.PrivateMessage) (inserted bool, err error) {
 	var tx *sqlx.Tx
 	tx, err = Client.Beginx()
 	if err != nil {
-		return str.ErrMessageError
+		return false, str.ErrMessageError
 	}
-	// 使用 defer 确保在发生错误时回滚事务
+	// 使用 defer 确保在发生错误或消息重复时回滚事务
 	defer func() {
 		if p := recover(); p != nil {
 			tx.Rollback()
-		} else if err != nil {
+		} else if err != nil || !inserted {
 			tx.Rollback()
 		}
 	}()
 	privateChat := models.GetPrivateChat(message)
 	//检查会话是否存在
 	var privateChatId int64
-	if err = tx.Get(&privateChatId, checkPrivateChatExistSQL, privateChat.User1Id, privateChat.User2Id); err != nil {
+	if err = tx.Get(&privateChatId, checkPrivateChatExistSQL, privateChat.User1Id, privateChat.User2Id); err != nil && !errors.Is(err, sql.ErrNoRows) {
 		//查询出错
 		logging.Logger.Error("select private_chat error:",
 			zap.Error(err))
-		return err
+		return false, err
 	}
 	if privateChatId == 0 {
 		//不存在则插入会话
@@ -95,32 +141,37 @@ func InsertPrivateMsg(message *models.PrivateMessage) (err error) {
 		privateChatId = utils2.GetID()
 		privateChat.Id = privateChatId
 		message.PrivateChatId = privateChatId
-		if _, err = tx.Exec(insertPrivateChatSQL, privateChat.User1Id, privateChat.User2Id, privateChat.LastMsgContent, privateChat.LastSendTime); err != nil {
+		if _, err = tx.Exec(insertPrivateChatSQL, privateChat.Id, privateChat.User1Id, privateChat.User2Id, privateChat.LastMsgContent, privateChat.LastSendTime); err != nil {
 			logging.Logger.Error("insert private_chat error:",
 				zap.Error(err))
-			return err
+			return false, err
 		}
 	} else {
 		message.PrivateChatId = privateChatId
 		//存在则更新数据
-		if _, err = tx.Exec(updatePrivateChatSQL, privateChat.LastMsgContent, privateChat.User1Id, privateChat.User2Id); err != nil {
+		if _, err = tx.Exec(updatePrivateChatSQL, privateChat.LastMsgContent, privateChat.LastSendTime, privateChat.User1Id, privateChat.User2Id); err != nil {
 			logging.Logger.Error("update private_chat err:",
 				zap.Error(err))
-			return err
+			return false, err
 		}
 	}
-	//插入私信
-	if _, err = tx.Exec(insertPrivateMsgSQL, message.PrivateChatId, message.Id, message.SenderId, message.RecipientId, message.Status, message.SendTime); err != nil {
+	//插入私信，私信已存在时回滚会话的修改
+	var result sql.Result
+	if result, err = tx.Exec(insertPrivateMsgSQL, message.Id, message.SenderId, message.RecipientId, message.Content, message.Status, message.SendTime, message.PrivateChatId); err != nil {
 		logging.Logger.Error("insert private_msg error:",
 			zap.Error(err))
-		return err
+		return false, err
+	}
+	var rows int64
+	if rows, err = result.RowsAffected(); err != nil || rows == 0 {
+		return false, err
 	}
 	if err = tx.Commit(); err != nil {
 		logging.Logger.Error("commit tx error:",
 			zap.Error(err))
-		return err
+		return false, err
 	}
-	return nil
+	return true, nil
 }
 
 func InsertSystemMsg(message *models.SystemMessage) (err error) {
@@ -145,39 +196,23 @@ func InsertSystemMsg(message *models.SystemMessage) (err error) {
 		}
 	}()
 
-	if _, err = tx.Exec(insertSystemMsgSQL, message.Id, message.Title, message.Content, message.Type, message.Status, message.RecipientId, message.ManagerId, message.PublishTime); err != nil {
+	if _, err = tx.Exec(insertSystemMsgSQL, message.Id, message.Title, message.Content, message.Type, message.Status,
+		message.RecipientId, message.TargetId, message.ManagerId, message.PublishTime, time.Now().UTC()); err != nil {
 		logging.Logger.Error("insert system_msg error:",
 			zap.Error(err))
 		return err
 	}
-
-	if message.Type == "single" {
-		// 单个用户
-		if _, err = tx.Exec(insertSystemMsgUserSQL, utils2.GetID(), message.Id, message.RecipientId, false); err != nil {
-			logging.Logger.Error("insert user_system_msg error",
-				zap.Error(err))
-			return err
-		}
-	} else {
-		// 全体用户
-		var userIds []int64
-		if err = tx.Select(&userIds, queryAllUserIdSQL); err != nil {
-			logging.Logger.Error("query all users id error",
-				zap.Error(err))
-			return err
-		}
-		systemMessageUsers := make([]interface{}, 0, len(userIds))
-		for _, userId := range userIds {
-			systemMessageUser := &models.SystemMessageUser{
-				Id:              utils2.GetID(),
-				SystemMessageId: message.Id,
-				RecipientId:     userId,
-				Status:          message.Status,
+	// 通知只保存一份，用户拉取时再写入user_system_notice，指定用户列表时记录接收者
+	if message.Type == models.NoticeUsers && len(message.Recipients) > 0 {
+		recipients := make([]map[string]interface{}, len(message.Recipients))
+		for i, recipientId := range message.Recipients {
+			recipients[i] = map[string]interface{}{
+				"system_notice_id": message.Id,
+				"recipient_id":     recipientId,
 			}
-			systemMessageUsers = append(systemMessageUsers, systemMessageUser)
 		}
-		if _, err = tx.NamedExec(insertBatchSystemUser, systemMessageUsers); err != nil {
-			logging.Logger.Error("insert system_msg error:",
+		if _, err = tx.NamedExec(insertNoticeRecipientSQL, recipients); err != nil {
+			logging.Logger.Error("insert system_notice_recipient error:",
 				zap.Error(err))
 			return err
 		}
@@ -190,34 +225,50 @@ func InsertSystemMsg(message *models.SystemMessage) (err error) {
 	return nil
 }
 
-func UpdateLikeMessage(message *models.RemindMessage) error {
+// UpdateLikeMessage 保存点赞提醒，取消点赞时撤回对应的提醒，返回提醒是否有变化
+func UpdateLikeMessage(message *models.RemindMessage) (bool, error) {
 	if message.IsDeleted {
 		return deleteRemindMessage(deleteLikeMessageSQL, message)
 	}
 	return insertRemindMessage(insertLikeMessageSQL, message)
 }
 
-func InsertMentionMessage(message *models.RemindMessage) error {
+// UpdateMentionMessage 保存@提醒，帖子或评论被删除时撤回对应的提醒，返回提醒是否有变化
+func UpdateMentionMessage(message *models.RemindMessage) (bool, error) {
+	if message.IsDeleted {
+		return deleteRemindMessage(deleteMentionMessageSQL, message)
+	}
 	return insertRemindMessage(insertMentionMessageSQL, message)
 }
 
-func InsertReplyMessage(message *models.RemindMessage) error {
+// UpdateReplyMessage 保存回复提醒，评论被删除时撤回对应的提醒，返回提醒是否有变化
+func UpdateReplyMessage(message *models.RemindMessage) (bool, error) {
+	if message.IsDeleted {
+		return deleteRemindMessage(deleteReplyMessageSQL, message)
+	}
 	return insertRemindMessage(insertReplyMessageSQL, message)
 }
 
-func insertRemindMessage(query string, message *models.RemindMessage) error {
-	if _, err := Client.Exec(query, message.Id, message.SourceId, message.SourceType, message.Content,
-		message.Url, message.Status, message.SenderId, message.RecipientId, message.RemindTime); err != nil {
+// insertRemindMessage 以提醒id去重保存提醒，重复投递的提醒返回false
+func insertRemindMessage(query string, message *models.RemindMessage) (bool, error) {
+	result, err := Client.Exec(query, message.Id, message.SourceId, message.SourceType, message.Content,
+		message.Url, message.Status, message.SenderId, message.RecipientId, message.RemindTime)
+	if err != nil {
 		logging.Logger.Error("insert remind message error:", zap.Error(err), zap.Any("message", message))
-		return err
+		return false, err
 	}
-	return nil
+	rows, err := result.RowsAffected()
+	return rows > 0, err
 }
-func deleteRemindMessage(query string, message *models.RemindMessage) error {
-	if _, err := Client.Exec(query, time.Now().UTC(), message.SourceId, message.SourceType, message.SenderId, message.RecipientId); err != nil {
-		return err
+
+// deleteRemindMessage 撤回未撤回的提醒，重复投递时没有提醒被撤回，返回false
+func deleteRemindMessage(query string, message *models.RemindMessage) (bool, error) {
+	result, err := Client.Exec(query, time.Now().UTC(), message.SourceId, message.SourceType, message.SenderId, message.RecipientId)
+	if err != nil {
+		return false, err
 	}
-	return nil
+	rows, err := result.RowsAffected()
+	return rows > 0, err
 }
 
 func LoadMessage(privateChatId int64, lastMsgTime time.Time, limit int) ([]*models.PrivateMessage, error) {
@@ -243,3 +294,203 @@ func GetAllPrivateChat() ([]*models.PrivateChat, error) {
 	}
 	return chats, nil
 }
+
+// ReadPrivateMessage 将对方发来的、id不大于lastMessageId的私信标记为已读，返回标记的条数
+func ReadPrivateMessage(recipientId int64, senderId int64, lastMessageId int64) (int64, error) {
+	result, err := Client.Exec(readPrivateMessageSQL, recipientId, senderId, lastMessageId)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// 提醒类型对应的已读SQL
+var readRemindSQL = map[string]string{
+	RemindLike:    readLikeRemindSQL,
+	RemindMention: readMentionRemindSQL,
+	RemindReply:   readReplyRemindSQL,
+	RemindSystem:  readSystemNoticeSQL,
+}
+
+// 提醒类型
+const (
+	RemindLike    = "like"
+	RemindMention = "mention"
+	RemindReply   = "reply"
+	RemindSystem  = "system"
+)
+
+// ReadRemindMessage 将用户某一类型的提醒全部标记为已读
+func ReadRemindMessage(recipientId int64, remindType string) error {
+	query, ok := readRemindSQL[remindType]
+	if !ok {
+		return str.ErrInvalidParam
+	}
+	_, err := Client.Exec(query, recipientId)
+	return err
+}
+
+// ReadAllMessage 将用户的私信和所有提醒标记为已读
+func ReadAllMessage(recipientId int64) (err error) {
+	var tx *sqlx.Tx
+	tx, err = Client.Beginx()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+	for _, query := range []string{readAllPrivateMsgSQL, readLikeRemindSQL, readMentionRemindSQL, readReplyRemindSQL, readSystemNoticeSQL} {
+		if _, err = tx.Exec(query, recipientId); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// ListLikeRemind 按点赞源聚合加载id小于cursor的点赞提醒，每组带上最近的senderLimit个点赞用户
+func ListLikeRemind(recipientId int64, cursor int64, limit int, senderLimit int) ([]*models.LikeRemind, error) {
+	var reminds []*models.LikeRemind
+	if err := Client.Select(&reminds, listLikeRemindSQL, recipientId, cursor, limit); err != nil {
+		return nil, err
+	}
+	if len(reminds) == 0 {
+		return reminds, nil
+	}
+	//一次查询本页每个点赞源最近的几个点赞用户
+	sourceIds := make([]int64, len(reminds))
+	for i, remind := range reminds {
+		sourceIds[i] = remind.SourceId
+	}
+	query, args, err := sqlx.In(listLikeSenderSQL, recipientId, sourceIds, senderLimit)
+	if err != nil {
+		return nil, err
+	}
+	var senders []*models.RemindMessage
+	if err := Client.Select(&senders, Client.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+	type likeSource struct {
+		id         int64
+		sourceType string
+	}
+	sourceSenders := make(map[likeSource][]*models.RemindMessage, len(reminds))
+	for _, sender := range senders {
+		source := likeSource{id: sender.SourceId, sourceType: sender.SourceType}
+		sourceSenders[source] = append(sourceSenders[source], sender)
+	}
+	for _, remind := range reminds {
+		senders := sourceSenders[likeSource{id: remind.SourceId, sourceType: remind.SourceType}]
+		remind.SenderIds = make([]int64, len(senders))
+		for i, sender := range senders {
+			remind.SenderIds[i] = sender.SenderId
+		}
+		if len(senders) > 0 {
+			remind.SenderId = senders[0].SenderId
+			remind.Content = senders[0].Content
+			remind.Url = senders[0].Url
+		}
+		remind.RecipientId = recipientId
+	}
+	return reminds, nil
+}
+
+// 提醒类型对应的列表SQL，点赞提醒需要聚合，单独查询
+var listRemindSQL = map[string]string{
+	RemindMention: listMentionRemindSQL,
+	RemindReply:   listReplyRemindSQL,
+}
+
+// ListRemindMessage 加载id小于cursor的回复或@提醒
+func ListRemindMessage(recipientId int64, remindType string, cursor int64, limit int) ([]*models.RemindMessage, error) {
+	query, ok := listRemindSQL[remindType]
+	if !ok {
+		return nil, str.ErrInvalidParam
+	}
+	var reminds []*models.RemindMessage
+	if err := Client.Select(&reminds, query, recipientId, cursor, limit); err != nil {
+		return nil, err
+	}
+	return reminds, nil
+}
+
+// ListSystemNotice 加载id小于cursor的系统通知
+func ListSystemNotice(recipientId int64, cursor int64, limit int) ([]*models.UserSystemNotice, error) {
+	var notices []*models.UserSystemNotice
+	if err := Client.Select(&notices, listSystemNoticeSQL, recipientId, cursor, limit); err != nil {
+		return nil, err
+	}
+	return notices, nil
+}
+
+// ListPendingNotice 获取since之后写入或发布、到until时已发布、未撤回且可能通知到该用户的系统通知，
+// 按写入时间判断写入较晚的通知，按发布时间判断定时通知
+func ListPendingNotice(recipientId int64, since time.Time, until time.Time) ([]*models.SystemMessage, error) {
+	var notices []*models.SystemMessage
+	if err := Client.Select(&notices, listPendingNoticeSQL, since, since, until, recipientId); err != nil {
+		return nil, err
+	}
+	return notices, nil
+}
+
+// FilterNoticeRecipient 返回接收者列表中包含该用户的通知id
+func FilterNoticeRecipient(recipientId int64, noticeIds []int64) ([]int64, error) {
+	if len(noticeIds) == 0 {
+		return nil, nil
+	}
+	query, args, err := sqlx.In(filterNoticeRecipientSQL, recipientId, noticeIds)
+	if err != nil {
+		return nil, err
+	}
+	var ids []int64
+	if err := Client.Select(&ids, Client.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// InsertUserNotice 将拉取到的通知写入用户的通知列表，已写入过的忽略，返回新写入的条数
+func InsertUserNotice(notices []*models.SystemMessageUser) (int64, error) {
+	if len(notices) == 0 {
+		return 0, nil
+	}
+	result, err := Client.NamedExec(insertBatchSystemUser, notices)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// RevokeSystemNotice 撤回系统通知并删除用户已拉取的记录，返回有未读通知被删除的用户
+func RevokeSystemNotice(noticeId int64) (recipients []int64, err error) {
+	var tx *sqlx.Tx
+	tx, err = Client.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+	result, err := tx.Exec(revokeSystemNoticeSQL, time.Now().UTC(), noticeId)
+	if err != nil {
+		return nil, err
+	}
+	var n int64
+	if n, err = result.RowsAffected(); err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, str.ErrNoticeNotExists
+	}
+	if err = tx.Select(&recipients, queryUnreadNoticeUserSQL, noticeId); err != nil {
+		return nil, err
+	}
+	if _, err = tx.Exec(deleteUserNoticeSQL, noticeId); err != nil {
+		return nil, err
+	}
+	return recipients, tx.Commit()
+}
@@ -0,0 +1,29 @@
+package mysql
+
+import (
+	"database/sql"
+	"errors"
+)
+
+const (
+	getMigrationSQL    = "select count(1) from data_migration where name=?"
+	finishMigrationSQL = "insert ignore into data_migration(name) values (?)"
+)
+
+// MigrationDone 数据迁移是否已经完成
+func MigrationDone(name string) (bool, error) {
+	var count int64
+	if err := Client.Get(&count, getMigrationSQL, name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// FinishMigration 记录数据迁移已完成
+func FinishMigration(name string) error {
+	_, err := Client.Exec(finishMigrationSQL, name)
+	return err
+}
@@ -1,18 +1,29 @@
 package mysql
 
 import (
+	"database/sql"
+	"errors"
+	"star/app/constant/str"
 	"star/app/models"
+	"star/app/utils/snowflake"
 	"strconv"
+	"time"
 )
 
 const (
-	insertPostSQL = "insert into post(postId, userId,collection,star,content,isScan,communityId) values(?,?,?,?,?,?,?)"
+	insertPostSQL = "insert into post(postId, userId,collection,star,content,video,isScan,communityId) values(?,?,?,?,?,?,?,?)"
 	countPostSQL  = "select count(1) from post where userId=?"
 	listPostSQL   = "select postId,userId,content,isScan,communityId from post where deletedAt is NULL and userId=? order by createdAt desc"
+	listPostIdSQL = "select postId from post where deletedAt is NULL and isScan=true and userId=? order by postId desc limit ?"
+	getPostSQL    = "select postId,userId,content,isScan,communityId from post where postId=? and deletedAt is NULL"
+	updatePostSQL = "update post set content=?,isScan=? where postId=? and userId=? and deletedAt is NULL"
+	deletePostSQL = "update post set deletedAt=? where postId=? and userId=? and deletedAt is NULL"
+
+	insertPostEditHistorySQL = "insert into post_edit_history(historyId,postId,userId,content,isScan,editTime) values(?,?,?,?,?,?)"
 )
 
 func InsertPost(post *models.Post) error {
-	if _, err := Client.Exec(insertPostSQL, post.PostId, post.UserId, post.Collection, post.Star, post.Content, post.IsScan, post.CommunityId); err != nil {
+	if _, err := Client.Exec(insertPostSQL, post.PostId, post.UserId, post.Collection, post.Star, post.Content, post.Video, post.IsScan, post.CommunityId); err != nil {
 		return err
 	}
 	return nil
@@ -33,3 +44,61 @@ func ListPost(userId int64) ([]*models.Post, error) {
 	}
 	return posts, nil
 }
+
+// ListPostId 获取用户最近发布的帖子id
+func ListPostId(userId int64, limit int64) ([]int64, error) {
+	var postIds []int64
+	if err := Client.Select(&postIds, listPostIdSQL, userId, limit); err != nil {
+		return nil, err
+	}
+	return postIds, nil
+}
+
+// GetPost 获取未删除的帖子
+func GetPost(postId int64) (*models.Post, error) {
+	post := new(models.Post)
+	if err := Client.Get(post, getPostSQL, postId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, str.ErrPostNotExists
+		}
+		return nil, err
+	}
+	return post, nil
+}
+
+// UpdatePost 修改帖子，修改前的内容记入编辑历史
+func UpdatePost(old *models.Post, content string, isScan bool) (err error) {
+	tx, err := Client.Beginx()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+	if _, err = tx.Exec(insertPostEditHistorySQL, snowflake.GetID(), old.PostId, old.UserId, old.Content, old.IsScan, time.Now().UTC()); err != nil {
+		return err
+	}
+	result, err := tx.Exec(updatePostSQL, content, isScan, old.PostId, old.UserId)
+	if err != nil {
+		return err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		err = str.ErrPostNotExists
+		return err
+	}
+	return tx.Commit()
+}
+
+// DeletePost 软删除帖子
+func DeletePost(postId int64, userId int64) error {
+	result, err := Client.Exec(deletePostSQL, time.Now().UTC(), postId, userId)
+	if err != nil {
+		return err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return str.ErrPostNotExists
+	}
+	return nil
+}
@@ -23,6 +23,7 @@ const (
 	unBothFollowSQL      = "update user_follows set status=false where user_id=? and be_followed_id=?"
 	unBothFansSQL        = "update  user_fans set status=false where user_id=? and fans_id=?"
 	getFansIdListSQL     = "select fans_id from user_fans where user_id=? and deletedAt IS NULL limit 100"
+	getAllFansIdListSQL  = "select fans_id from user_fans where user_id=? and deletedAt IS NULL"
 	getFollowerCountSQL  = "select count(1) from user_follows where user_id=? and deletedAt IS NOT NULL"
 	getFansCountSQL      = "select count(1) from user_fans where user_id=? and deletedAt IS NULL"
 	isFollowSQL          = "select count(1) from user_follows where user_id=? and be_followed_id=? and  deletedAt IS NULL"
@@ -44,6 +45,15 @@ func GetFansIdList(userId int64) ([]int64, error) {
 	return fansIdList, nil
 }
 
+// GetAllFansIdList 获取用户全部粉丝id,用于发帖推送
+func GetAllFansIdList(userId int64) ([]int64, error) {
+	var fansIdList []int64
+	if err := Client.Select(&fansIdList, getAllFansIdListSQL, userId); err != nil {
+		return nil, err
+	}
+	return fansIdList, nil
+}
+
 func GetFollowCount(userId int64) (int64, error) {
 	var count int64
 	if err := Client.Get(&count, getFollowerCountSQL, userId); err != nil {
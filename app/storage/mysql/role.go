@@ -0,0 +1,64 @@
+package mysql
+
+import (
+	"database/sql"
+	"errors"
+	"star/app/constant/str"
+	"star/app/models"
+)
+
+const (
+	getRoleSQL            = "select roleCode,roleName,scope from role where roleCode=?"
+	getRolePermissionsSQL = "select roleCode,permissionCode from role_permission"
+	getUserRolesSQL       = "select userId,roleCode,communityId,grantedBy,createdAt from user_role where userId=?"
+	grantRoleSQL          = "insert ignore into user_role(userId,roleCode,communityId,grantedBy) values (?,?,?,?)"
+	revokeRoleSQL         = "delete from user_role where userId=? and roleCode=? and communityId=?"
+)
+
+// GetRole 获取角色，不存在时返回str.ErrRoleNotExists
+func GetRole(roleCode string) (*models.Role, error) {
+	role := new(models.Role)
+	if err := Client.Get(role, getRoleSQL, roleCode); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, str.ErrRoleNotExists
+		}
+		return nil, err
+	}
+	return role, nil
+}
+
+// GetRolePermissions 获取所有角色的权限
+func GetRolePermissions() ([]*models.RolePermission, error) {
+	var permissions []*models.RolePermission
+	if err := Client.Select(&permissions, getRolePermissionsSQL); err != nil {
+		return nil, err
+	}
+	return permissions, nil
+}
+
+// GetUserRoles 获取用户的全局角色和所有社区角色
+func GetUserRoles(userId int64) ([]*models.UserRole, error) {
+	var roles []*models.UserRole
+	if err := Client.Select(&roles, getUserRolesSQL, userId); err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+// GrantRole 授予角色，用户已有该角色时不做修改
+func GrantRole(role *models.UserRole) error {
+	_, err := Client.Exec(grantRoleSQL, role.UserId, role.RoleCode, role.CommunityId, role.GrantedBy)
+	return err
+}
+
+// RevokeRole 撤销角色，用户没有该角色时返回str.ErrUserRoleNotExists
+func RevokeRole(userId int64, roleCode string, communityId int64) error {
+	result, err := Client.Exec(revokeRoleSQL, userId, roleCode, communityId)
+	if err != nil {
+		return err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return str.ErrUserRoleNotExists
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package mysql
 import (
 	"database/sql"
 	"errors"
+	"github.com/jmoiron/sqlx"
 	"star/app/constant/str"
 	"star/app/models"
 	"time"
@@ -15,6 +16,7 @@ const (
 	insertUserSQL           = "INSERT INTO user_info(user_id, username,password,phone,avatar,person_introduction,sex,join_time,total_coin_count,current_coin_count) VALUES (?,?, ?,?, ?,?,?,?,?,?)"
 	queryUserInfoSQL        = "select  user_id,username,phone,email,person_introduction,avatar,birthday,school,notice_info,last_login_ip,total_coin_count,current_coin_count,theme,sex,status,last_login_time,join_time from user_info  where user_id=?"
 	updateLoginTimeAndIpSQL = "update user_info set last_login_time=?,last_login_ip=? where user_id=? "
+	queryUserIdsByNameSQL   = "SELECT user_id, username FROM user_info WHERE username IN (?)"
 )
 
 // QueryUserByPhone 通过手机号查询用户密码
@@ -69,3 +71,23 @@ func UpdateLoginTimeAndIp(loginTime time.Time, loginIp string, userId int64) err
 	}
 	return nil
 }
+
+// GetUserIdsByUsername 根据用户名批量查询用户id
+func GetUserIdsByUsername(usernames []string) (map[string]int64, error) {
+	userIds := make(map[string]int64, len(usernames))
+	if len(usernames) == 0 {
+		return userIds, nil
+	}
+	query, args, err := sqlx.In(queryUserIdsByNameSQL, usernames)
+	if err != nil {
+		return nil, err
+	}
+	var users []*models.User
+	if err := Client.Select(&users, Client.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		userIds[user.Username] = user.UserId
+	}
+	return userIds, nil
+}
@@ -4,16 +4,18 @@ import (
 	"context"
 	"fmt"
 	redis2 "github.com/redis/go-redis/v9"
+	"star/app/models"
 	"time"
 )
 
 func CollectPostAction(ctx context.Context, userId int64, postId int64) error {
 	_, err := Client.TxPipelined(ctx, func(pipe redis2.Pipeliner) error {
-		pipe.IncrBy(ctx, fmt.Sprintf("post:%d:collected_count", postId), 1)
+		pipe.IncrBy(ctx, FavorCountKey(models.FavorPostCollect, postId), 1)
 		pipe.ZAdd(ctx, fmt.Sprintf("user:%d:collect_posts", userId), redis2.Z{
 			Member: postId,
 			Score:  float64(time.Now().Unix()),
 		})
+		markFavorChanged(ctx, pipe, models.FavorPostCollect, postId)
 		return nil
 	})
 	return err
@@ -21,8 +23,9 @@ func CollectPostAction(ctx context.Context, userId int64, postId int64) error {
 
 func UnCollectPostAction(ctx context.Context, userId int64, postId int64) error {
 	_, err := Client.TxPipelined(ctx, func(pipe redis2.Pipeliner) error {
-		pipe.IncrBy(ctx, fmt.Sprintf("post:%d:collected_count", postId), -1)
+		pipe.IncrBy(ctx, FavorCountKey(models.FavorPostCollect, postId), -1)
 		pipe.ZRem(ctx, fmt.Sprintf("user:%d:collect_posts", userId), postId)
+		markFavorChanged(ctx, pipe, models.FavorPostCollect, postId)
 		return nil
 	})
 	return err
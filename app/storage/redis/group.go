@@ -0,0 +1,118 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	redis2 "github.com/redis/go-redis/v9"
+	"star/app/models"
+	"strconv"
+)
+
+// 社区群聊最近消息缓存，列表头部为最新的消息
+const groupMessageCacheLength = 200
+
+// GroupChatChannel 群聊消息广播频道，各消息服务实例订阅后推送给本实例上的在线成员
+const GroupChatChannel = "GroupChat"
+
+func groupMessageKey(communityId int64) string {
+	return fmt.Sprintf("GroupMessage:%d", communityId)
+}
+
+func groupReadKey(userId int64) string {
+	return fmt.Sprintf("GroupRead:%d", userId)
+}
+
+// 只在新的已读位置更大时才更新，避免多端乱序上报导致已读位置回退
+var setGroupReadScript = redis2.NewScript(`
+local old = tonumber(redis.call('HGET', KEYS[1], ARGV[1]) or '0')
+if tonumber(ARGV[2]) > old then
+    redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+end
+return 0
+`)
+
+// SaveGroupMessage 将群聊消息写入社区最近消息缓存，只保留最近groupMessageCacheLength条
+func SaveGroupMessage(ctx context.Context, message *models.GroupMessage) error {
+	key := groupMessageKey(message.CommunityId)
+	_, err := Client.TxPipelined(ctx, func(pipe redis2.Pipeliner) error {
+		pipe.LPush(ctx, key, message)
+		pipe.LTrim(ctx, key, 0, groupMessageCacheLength-1)
+		return nil
+	})
+	return err
+}
+
+// getGroupMessages 获取社区缓存中的全部消息，按时间倒序
+func getGroupMessages(ctx context.Context, communityId int64) ([]*models.GroupMessage, error) {
+	var messages []*models.GroupMessage
+	if err := Client.LRange(ctx, groupMessageKey(communityId), 0, -1).ScanSlice(&messages); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// LoadGroupMessage 从缓存中按时间倒序加载chatId小于lastChatId的消息，最多limit条
+func LoadGroupMessage(ctx context.Context, communityId int64, lastChatId int64, limit int) ([]*models.GroupMessage, error) {
+	cached, err := getGroupMessages(ctx, communityId)
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]*models.GroupMessage, 0, limit)
+	for _, message := range cached {
+		if message.ChatId >= lastChatId {
+			continue
+		}
+		messages = append(messages, message)
+		if len(messages) == limit {
+			break
+		}
+	}
+	return messages, nil
+}
+
+// CountGroupUnread 统计社区缓存中chatId大于已读位置的消息数，最多统计到缓存长度
+func CountGroupUnread(ctx context.Context, communityId int64, readChatId int64) (int64, error) {
+	messages, err := getGroupMessages(ctx, communityId)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	for _, message := range messages {
+		if message.ChatId <= readChatId {
+			break
+		}
+		count++
+	}
+	return count, nil
+}
+
+// SetGroupRead 更新用户在社区群聊中的已读位置
+func SetGroupRead(ctx context.Context, userId int64, communityId int64, chatId int64) error {
+	return setGroupReadScript.Run(ctx, Client, []string{groupReadKey(userId)}, communityId, chatId).Err()
+}
+
+// GetGroupRead 获取用户在各社区群聊中的已读位置
+func GetGroupRead(ctx context.Context, userId int64) (map[int64]int64, error) {
+	values, err := Client.HGetAll(ctx, groupReadKey(userId)).Result()
+	if err != nil {
+		return nil, err
+	}
+	reads := make(map[int64]int64, len(values))
+	for field, value := range values {
+		communityId, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			continue
+		}
+		chatId, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		reads[communityId] = chatId
+	}
+	return reads, nil
+}
+
+// PublishGroupMessage 将群聊消息广播给所有消息服务实例
+func PublishGroupMessage(ctx context.Context, message *models.GroupMessage) error {
+	return Client.Publish(ctx, GroupChatChannel, message).Err()
+}
@@ -2,19 +2,50 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	redis2 "github.com/redis/go-redis/v9"
+	"star/app/models"
+	"strconv"
+	"strings"
 	"time"
 )
 
+const (
+	favorReconcileLockKey = "FavorReconcileLock"
+	favorBackfillLockKey  = "FavorBackfillLock:%s"
+)
+
+// FavorCountKey 帖子、评论的点赞数和帖子收藏数的缓存key
+func FavorCountKey(kind string, sourceId int64) string {
+	switch kind {
+	case models.FavorCommentLike:
+		return fmt.Sprintf("comment:%d:liked_count", sourceId)
+	case models.FavorPostCollect:
+		return fmt.Sprintf("post:%d:collected_count", sourceId)
+	}
+	return fmt.Sprintf("post:%d:liked_count", sourceId)
+}
+
+// favorChangedKey 计数最近有变化的id，score为变化时间，对账时只处理这些id
+func favorChangedKey(kind string) string {
+	return "FavorChanged:" + kind
+}
+
+// markFavorChanged 在计数变化的事务中记录变化的id
+func markFavorChanged(ctx context.Context, pipe redis2.Pipeliner, kind string, sourceId int64) {
+	pipe.ZAdd(ctx, favorChangedKey(kind), redis2.Z{
+		Member: sourceId,
+		Score:  float64(time.Now().Unix()),
+	})
+}
+
 func LikePostAction(ctx context.Context, userId int64, postId int64, userLikedId int64) error {
 	_, err := Client.TxPipelined(ctx, func(pipe redis2.Pipeliner) error {
-		pipe.IncrBy(ctx, fmt.Sprintf("post:%d:liked_count", postId), 1)
+		pipe.IncrBy(ctx, FavorCountKey(models.FavorPostLike, postId), 1)
 		pipe.IncrBy(ctx, fmt.Sprintf("user:%d:liked_count", userLikedId), 1)
-		pipe.ZAdd(ctx, fmt.Sprintf("user:%d:like_posts", userId), redis2.Z{
-			Member: postId,
-			Score:  float64(time.Now().Unix()),
-		})
+		addLiked(ctx, pipe, models.LikeSourcePost, userId, postId)
+		markFavorChanged(ctx, pipe, models.FavorPostLike, postId)
 		return nil
 	})
 	return err
@@ -22,27 +53,276 @@ func LikePostAction(ctx context.Context, userId int64, postId int64, userLikedId
 
 func UnlikePostAction(ctx context.Context, userId int64, postId int64, userLikedId int64) error {
 	_, err := Client.TxPipelined(ctx, func(pipe redis2.Pipeliner) error {
-		pipe.IncrBy(ctx, fmt.Sprintf("post:%d:liked_count", postId), -1)
+		pipe.IncrBy(ctx, FavorCountKey(models.FavorPostLike, postId), -1)
 		pipe.IncrBy(ctx, fmt.Sprintf("user:%d:liked_count", userLikedId), -1)
-		pipe.ZRem(ctx, fmt.Sprintf("user:%d:like_posts", userId), postId)
+		removeLiked(ctx, pipe, models.LikeSourcePost, userId, postId)
+		markFavorChanged(ctx, pipe, models.FavorPostLike, postId)
 		return nil
 	})
 	return err
 }
 
-func LikeCommentAction(ctx context.Context, commentId int64, userLikedId int64) error {
+func LikeCommentAction(ctx context.Context, userId int64, commentId int64, userLikedId int64) error {
 	_, err := Client.TxPipelined(ctx, func(pipe redis2.Pipeliner) error {
-		pipe.IncrBy(ctx, fmt.Sprintf("comment:%d:liked_count", commentId), 1)
+		pipe.IncrBy(ctx, FavorCountKey(models.FavorCommentLike, commentId), 1)
 		pipe.IncrBy(ctx, fmt.Sprintf("user:%d:liked_count", userLikedId), 1)
+		addLiked(ctx, pipe, models.LikeSourceComment, userId, commentId)
+		markFavorChanged(ctx, pipe, models.FavorCommentLike, commentId)
 		return nil
 	})
 	return err
 }
-func UnLikeCommentAction(ctx context.Context, commentId int64, userLikedId int64) error {
+
+func UnLikeCommentAction(ctx context.Context, userId int64, commentId int64, userLikedId int64) error {
 	_, err := Client.TxPipelined(ctx, func(pipe redis2.Pipeliner) error {
-		pipe.IncrBy(ctx, fmt.Sprintf("comment:%d:liked_count", commentId), -1)
+		pipe.IncrBy(ctx, FavorCountKey(models.FavorCommentLike, commentId), -1)
 		pipe.IncrBy(ctx, fmt.Sprintf("user:%d:liked_count", userLikedId), -1)
+		removeLiked(ctx, pipe, models.LikeSourceComment, userId, commentId)
+		markFavorChanged(ctx, pipe, models.FavorCommentLike, commentId)
 		return nil
 	})
 	return err
 }
+
+// 用户点赞列表和加载标记使用相同的过期时间，每次点赞时一起续期，避免只剩下标记而列表已过期
+const likedExpire = 7 * 24 * time.Hour
+
+// likedKey 用户点赞的帖子或评论，score为点赞时间
+func likedKey(sourceType int, userId int64) string {
+	if sourceType == models.LikeSourceComment {
+		return fmt.Sprintf("user:%d:like_comments", userId)
+	}
+	return fmt.Sprintf("user:%d:like_posts", userId)
+}
+
+// likedLoadedKey 用户的点赞已从mysql加载到缓存的标记，没有标记时缓存中的点赞列表可能不完整
+func likedLoadedKey(sourceType int, userId int64) string {
+	return likedKey(sourceType, userId) + "_loaded"
+}
+
+func addLiked(ctx context.Context, pipe redis2.Pipeliner, sourceType int, userId int64, sourceId int64) {
+	pipe.ZAdd(ctx, likedKey(sourceType, userId), redis2.Z{
+		Member: sourceId,
+		Score:  float64(time.Now().Unix()),
+	})
+	expireLiked(ctx, pipe, sourceType, userId)
+}
+
+func removeLiked(ctx context.Context, pipe redis2.Pipeliner, sourceType int, userId int64, sourceId int64) {
+	pipe.ZRem(ctx, likedKey(sourceType, userId), sourceId)
+	expireLiked(ctx, pipe, sourceType, userId)
+}
+
+func expireLiked(ctx context.Context, pipe redis2.Pipeliner, sourceType int, userId int64) {
+	pipe.Expire(ctx, likedKey(sourceType, userId), likedExpire)
+	pipe.Expire(ctx, likedLoadedKey(sourceType, userId), likedExpire)
+}
+
+// LikedLoaded 用户点赞的帖子或评论是否已加载到缓存
+func LikedLoaded(ctx context.Context, sourceType int, userId int64) (bool, error) {
+	n, err := Client.Exists(ctx, likedLoadedKey(sourceType, userId)).Result()
+	return n > 0, err
+}
+
+// WarmLiked 将mysql中用户点赞的帖子或评论写入缓存，保留缓存中加载前新增的点赞
+func WarmLiked(ctx context.Context, sourceType int, userId int64, records []*models.LikeRecord) error {
+	_, err := Client.TxPipelined(ctx, func(pipe redis2.Pipeliner) error {
+		if len(records) > 0 {
+			members := make([]redis2.Z, len(records))
+			for i, record := range records {
+				members[i] = redis2.Z{
+					Member: record.SourceId,
+					Score:  float64(record.LikeTime.Unix()),
+				}
+			}
+			pipe.ZAddNX(ctx, likedKey(sourceType, userId), members...)
+		}
+		pipe.Set(ctx, likedLoadedKey(sourceType, userId), 1, likedExpire)
+		pipe.Expire(ctx, likedKey(sourceType, userId), likedExpire)
+		return nil
+	})
+	return err
+}
+
+// IsLiked 用户是否点赞了帖子或评论，需要先加载用户的点赞
+func IsLiked(ctx context.Context, sourceType int, userId int64, sourceId int64) (bool, error) {
+	_, err := Client.ZScore(ctx, likedKey(sourceType, userId), strconv.FormatInt(sourceId, 10)).Result()
+	if errors.Is(err, redis2.Nil) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// ScanLikePosts 遍历所有用户点赞帖子的缓存，用于将点赞记录补入mysql
+func ScanLikePosts(ctx context.Context, fn func(userId int64, records []*models.LikeRecord) error) error {
+	return scanUserFavor(ctx, "user:%d:like_posts", fn)
+}
+
+// ScanCollectPosts 遍历所有用户收藏帖子的缓存，用于将收藏记录补入mysql，记录的SourceId为帖子id
+func ScanCollectPosts(ctx context.Context, fn func(userId int64, records []*models.LikeRecord) error) error {
+	return scanUserFavor(ctx, "user:%d:collect_posts", fn)
+}
+
+// scanUserFavor 遍历key格式为format的用户点赞、收藏缓存，成员为帖子id，分数为操作时间
+func scanUserFavor(ctx context.Context, format string, fn func(userId int64, records []*models.LikeRecord) error) error {
+	iter := Client.Scan(ctx, 0, strings.Replace(format, "%d", "*", 1), 1000).Iterator()
+	for iter.Next(ctx) {
+		var userId int64
+		if _, err := fmt.Sscanf(iter.Val(), format, &userId); err != nil {
+			continue
+		}
+		members, err := Client.ZRangeWithScores(ctx, iter.Val(), 0, -1).Result()
+		if err != nil {
+			return err
+		}
+		records := make([]*models.LikeRecord, 0, len(members))
+		for _, member := range members {
+			sourceId, err := strconv.ParseInt(fmt.Sprint(member.Member), 10, 64)
+			if err != nil {
+				continue
+			}
+			records = append(records, &models.LikeRecord{
+				SourceId: sourceId,
+				LikeTime: time.Unix(int64(member.Score), 0),
+			})
+		}
+		if err := fn(userId, records); err != nil {
+			return err
+		}
+	}
+	return iter.Err()
+}
+
+// ListChangedFavor 获取before之前计数有变化的id
+func ListChangedFavor(ctx context.Context, kind string, before time.Time, limit int64) ([]int64, error) {
+	members, err := Client.ZRangeByScore(ctx, favorChangedKey(kind), &redis2.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(before.Unix(), 10),
+		Count: limit,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(members))
+	for _, member := range members {
+		if id, err := strconv.ParseInt(member, 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// 只删除变化时间不晚于对账开始时间的id，对账期间再次变化的id留到下次对账
+var clearChangedFavorScript = redis2.NewScript(`
+local n = 0
+for i = 1, #ARGV - 1 do
+    local score = redis.call('ZSCORE', KEYS[1], ARGV[i])
+    if score and tonumber(score) <= tonumber(ARGV[#ARGV]) then
+        n = n + redis.call('ZREM', KEYS[1], ARGV[i])
+    end
+end
+return n
+`)
+
+// ClearChangedFavor 对账完成后删除记录的变化
+func ClearChangedFavor(ctx context.Context, kind string, ids []int64, before time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	args := make([]interface{}, 0, len(ids)+1)
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	args = append(args, before.Unix())
+	return clearChangedFavorScript.Run(ctx, Client, []string{favorChangedKey(kind)}, args...).Err()
+}
+
+// GetFavorChangedTime 获取id最近一次计数变化的时间，没有记录的id不返回
+func GetFavorChangedTime(ctx context.Context, kind string, ids []int64) (map[int64]time.Time, error) {
+	changed := make(map[int64]time.Time)
+	if len(ids) == 0 {
+		return changed, nil
+	}
+	members := make([]string, len(ids))
+	for i, id := range ids {
+		members[i] = strconv.FormatInt(id, 10)
+	}
+	scores, err := Client.ZMScore(ctx, favorChangedKey(kind), members...).Result()
+	if err != nil {
+		return nil, err
+	}
+	for i, score := range scores {
+		if score > 0 {
+			changed[ids[i]] = time.Unix(int64(score), 0)
+		}
+	}
+	return changed, nil
+}
+
+// GetFavorCounts 获取缓存的计数，未缓存的id不返回
+func GetFavorCounts(ctx context.Context, kind string, ids []int64) (map[int64]int64, error) {
+	counts := make(map[int64]int64)
+	if len(ids) == 0 {
+		return counts, nil
+	}
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = FavorCountKey(kind, id)
+	}
+	values, err := Client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	for i, value := range values {
+		s, ok := value.(string)
+		if !ok {
+			continue
+		}
+		if count, err := strconv.ParseInt(s, 10, 64); err == nil {
+			counts[ids[i]] = count
+		}
+	}
+	return counts, nil
+}
+
+// 缓存的值与读取时一致才写入，避免覆盖对账期间的点赞；ARGV依次为读取时的值(未缓存为空)和要写入的值
+var setFavorCountScript = redis2.NewScript(`
+local n = 0
+for i = 1, #KEYS do
+    local current = redis.call('GET', KEYS[i]) or ''
+    if current == ARGV[2 * i - 1] then
+        redis.call('SET', KEYS[i], ARGV[2 * i])
+        n = n + 1
+    end
+end
+return n
+`)
+
+// SetFavorCounts 修复缓存的计数，observed为读取时的值，不包含未缓存的id，返回修复的数量
+func SetFavorCounts(ctx context.Context, kind string, observed map[int64]int64, target map[int64]int64) (int64, error) {
+	if len(target) == 0 {
+		return 0, nil
+	}
+	keys := make([]string, 0, len(target))
+	args := make([]interface{}, 0, len(target)*2)
+	for id, count := range target {
+		keys = append(keys, FavorCountKey(kind, id))
+		if current, ok := observed[id]; ok {
+			args = append(args, strconv.FormatInt(current, 10))
+		} else {
+			args = append(args, "")
+		}
+		args = append(args, count)
+	}
+	return setFavorCountScript.Run(ctx, Client, keys, args...).Int64()
+}
+
+// LockFavorReconcile 多个实例中只有一个执行定时对账
+func LockFavorReconcile(ctx context.Context, expire time.Duration) (bool, error) {
+	return Client.SetNX(ctx, favorReconcileLockKey, 1, expire).Result()
+}
+
+// LockFavorBackfill 多个实例中只有一个执行名为name的点赞、收藏记录补写
+func LockFavorBackfill(ctx context.Context, name string, expire time.Duration) (bool, error) {
+	return Client.SetNX(ctx, fmt.Sprintf(favorBackfillLockKey, name), 1, expire).Result()
+}
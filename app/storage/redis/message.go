@@ -2,6 +2,7 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
@@ -81,3 +82,102 @@ func getMessageKey(message *models.PrivateMessage) string {
 	chat := models.GetPrivateChat(message)
 	return fmt.Sprintf("chat:%d_%d", chat.User1Id, chat.User2Id)
 }
+
+// 用户未读数，hash的field与models.Counts的redis标签一致
+const (
+	CountLike       = "like"
+	CountMention    = "mention"
+	CountReply      = "reply"
+	CountSystem     = "system"
+	CountPrivateMsg = "private_msg"
+
+	messageCountExpire = 24 * time.Hour
+)
+
+func messageCountKey(userId int64) string {
+	return fmt.Sprintf("MessageCount:%d", userId)
+}
+
+// 只在未读数已缓存时增加，未缓存时下次读取会从mysql重新统计
+var incrMessageCountScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+    return redis.call('HINCRBY', KEYS[1], ARGV[1], ARGV[2])
+end
+return 0
+`)
+
+// GetMessageCount 获取缓存的未读数，未缓存时返回false
+func GetMessageCount(ctx context.Context, userId int64) (*models.Counts, bool, error) {
+	result := Client.HGetAll(ctx, messageCountKey(userId))
+	values, err := result.Result()
+	if err != nil {
+		return nil, false, err
+	}
+	if len(values) == 0 {
+		return nil, false, nil
+	}
+	counts := new(models.Counts)
+	if err := result.Scan(counts); err != nil {
+		return nil, false, err
+	}
+	return counts, true, nil
+}
+
+// SetMessageCount 缓存从mysql统计的未读数
+func SetMessageCount(ctx context.Context, userId int64, counts *models.Counts) error {
+	key := messageCountKey(userId)
+	_, err := Client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, counts)
+		pipe.Expire(ctx, key, messageCountExpire)
+		return nil
+	})
+	return err
+}
+
+// IncrMessageCount 新消息持久化后增加接收者对应类型的未读数
+func IncrMessageCount(ctx context.Context, userId int64, field string, n int64) error {
+	return incrMessageCountScript.Run(ctx, Client, []string{messageCountKey(userId)}, field, n).Err()
+}
+
+// DelMessageCount 标记已读或撤回提醒后删除缓存的未读数，下次读取时重新统计
+func DelMessageCount(ctx context.Context, userId int64) error {
+	return Client.Del(ctx, messageCountKey(userId)).Err()
+}
+
+// DelMessageCounts 批量删除缓存的未读数
+func DelMessageCounts(ctx context.Context, userIds []int64) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+	_, err := Client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, userId := range userIds {
+			pipe.Del(ctx, messageCountKey(userId))
+		}
+		return nil
+	})
+	return err
+}
+
+// NoticePullWindow 用户拉取系统通知的最长时间范围，超过该时间未拉取的用户只拉取这段时间内发布的通知
+const NoticePullWindow = 30 * 24 * time.Hour
+
+func noticePullKey(userId int64) string {
+	return fmt.Sprintf("SystemNoticePull:%d", userId)
+}
+
+// GetNoticePullTime 获取用户上次拉取系统通知的时间，未拉取过或已过期时返回false
+func GetNoticePullTime(ctx context.Context, userId int64) (time.Time, bool, error) {
+	pullTime, err := Client.Get(ctx, noticePullKey(userId)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return time.Time{}, false, nil
+	}
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return time.Unix(pullTime, 0).UTC(), true, nil
+}
+
+// SetNoticePullTime 记录用户拉取系统通知的时间
+func SetNoticePullTime(ctx context.Context, userId int64, pullTime time.Time) error {
+	return Client.Set(ctx, noticePullKey(userId), pullTime.Unix(), NoticePullWindow).Err()
+}
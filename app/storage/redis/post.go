@@ -10,6 +10,7 @@ import (
 	"math/rand/v2"
 	"star/app/models"
 	"star/app/storage/mysql"
+	"strconv"
 	"time"
 )
 
@@ -40,3 +41,26 @@ func GetPostInfo(ctx context.Context, postId int64) (*models.Post, error) {
 	}
 	return post, nil
 }
+
+// DeletePostInfo 删除帖子作者和内容的缓存
+func DeletePostInfo(ctx context.Context, postId int64) error {
+	return Client.Del(ctx, fmt.Sprintf("GetPostAuthorIdAndContent:%d", postId)).Err()
+}
+
+// RestoreListPost 帖子重新公开后按发布顺序放回用户发布的帖子列表，列表按帖子id从新到旧排列
+func RestoreListPost(ctx context.Context, userId int64, postId int64) error {
+	key := fmt.Sprintf("ListPost:%d", userId)
+	if err := Client.LRem(ctx, key, 0, postId).Err(); err != nil {
+		return err
+	}
+	ids, err := Client.LRange(ctx, key, 0, -1).Result()
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if n, _ := strconv.ParseInt(id, 10, 64); n < postId {
+			return Client.LInsertBefore(ctx, key, id, postId).Err()
+		}
+	}
+	return Client.RPush(ctx, key, postId).Err()
+}
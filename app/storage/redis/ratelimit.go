@@ -0,0 +1,54 @@
+package redis
+
+import (
+	"context"
+	redis2 "github.com/redis/go-redis/v9"
+	"time"
+)
+
+func rateLimitViolationKey(ip string) string {
+	return "RateLimit:violation:" + ip
+}
+
+func ipBanKey(ip string) string {
+	return "RateLimit:ban:" + ip
+}
+
+// GetIPBan 获取ip剩余的封禁时间，未被封禁时返回0
+func GetIPBan(ctx context.Context, ip string) (time.Duration, error) {
+	ttl, err := Client.TTL(ctx, ipBanKey(ip)).Result()
+	if err != nil {
+		return 0, err
+	}
+	//key不存在时ttl为负数
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+// 时间窗口内被限流的次数达到阈值后封禁ip并清空计数，ARGV依次为窗口秒数、阈值和封禁秒数
+var recordViolationScript = redis2.NewScript(`
+local n = redis.call('INCR', KEYS[1])
+if n == 1 then
+    redis.call('EXPIRE', KEYS[1], ARGV[1])
+end
+if n >= tonumber(ARGV[2]) then
+    redis.call('SET', KEYS[2], 1, 'EX', ARGV[3])
+    redis.call('DEL', KEYS[1])
+    return 1
+end
+return 0
+`)
+
+// RecordRateLimitViolation 记录ip被限流一次，返回ip是否因此被封禁
+func RecordRateLimitViolation(ctx context.Context, ip string, window time.Duration, threshold int64, banDuration time.Duration) (bool, error) {
+	banned, err := recordViolationScript.Run(ctx, Client, []string{rateLimitViolationKey(ip), ipBanKey(ip)},
+		int64(window/time.Second), threshold, int64(banDuration/time.Second)).Int64()
+	return banned == 1, err
+}
+
+// UnbanIP 解除ip的封禁
+func UnbanIP(ctx context.Context, ip string) error {
+	return Client.Del(ctx, ipBanKey(ip), rateLimitViolationKey(ip)).Err()
+}
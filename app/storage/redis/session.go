@@ -0,0 +1,148 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	redis2 "github.com/redis/go-redis/v9"
+	"star/app/models"
+	"time"
+)
+
+// 轮换refresh token的结果
+const (
+	SessionMissing = -1 //会话不存在或已过期
+	SessionReused  = 0  //使用的是已轮换过的refresh token
+	SessionRotated = 1
+)
+
+func sessionKey(sessionId string) string {
+	return "session:" + sessionId
+}
+
+// userSessionsKey 用户所有登录会话的id
+func userSessionsKey(userId int64) string {
+	return fmt.Sprintf("user:%d:sessions", userId)
+}
+
+// CreateSession 保存登录会话，会话在refresh token过期后失效
+func CreateSession(ctx context.Context, session *models.Session, expire time.Duration) error {
+	_, err := Client.TxPipelined(ctx, func(pipe redis2.Pipeliner) error {
+		pipe.HSet(ctx, sessionKey(session.SessionId), session)
+		pipe.Expire(ctx, sessionKey(session.SessionId), expire)
+		pipe.SAdd(ctx, userSessionsKey(session.UserId), session.SessionId)
+		pipe.Expire(ctx, userSessionsKey(session.UserId), expire)
+		return nil
+	})
+	return err
+}
+
+// GetSession 获取登录会话，不存在时返回false
+func GetSession(ctx context.Context, sessionId string) (*models.Session, bool, error) {
+	result := Client.HGetAll(ctx, sessionKey(sessionId))
+	values, err := result.Result()
+	if err != nil {
+		return nil, false, err
+	}
+	if len(values) == 0 {
+		return nil, false, nil
+	}
+	session := new(models.Session)
+	if err := result.Scan(session); err != nil {
+		return nil, false, err
+	}
+	return session, true, nil
+}
+
+// GetSessionUserId 获取会话所属的用户，会话不存在时返回0
+func GetSessionUserId(ctx context.Context, sessionId string) (int64, error) {
+	userId, err := Client.HGet(ctx, sessionKey(sessionId), "userId").Int64()
+	if err == redis2.Nil {
+		return 0, nil
+	}
+	return userId, err
+}
+
+// refresh token id与会话当前的一致时才轮换，ARGV依次为userId、旧id、新id、时间、ip和过期秒数
+var rotateSessionScript = redis2.NewScript(`
+local values = redis.call('HMGET', KEYS[1], 'userId', 'refreshId')
+if not values[1] or values[1] ~= ARGV[1] then
+    return -1
+end
+if values[2] ~= ARGV[2] then
+    return 0
+end
+redis.call('HSET', KEYS[1], 'refreshId', ARGV[3], 'lastActiveAt', ARGV[4], 'ip', ARGV[5])
+redis.call('EXPIRE', KEYS[1], ARGV[6])
+redis.call('EXPIRE', KEYS[2], ARGV[6])
+return 1
+`)
+
+// RotateSession 将会话的refresh token id从oldRefreshId换为newRefreshId并续期，返回SessionRotated等结果
+func RotateSession(ctx context.Context, userId int64, sessionId, oldRefreshId, newRefreshId, ip string, expire time.Duration) (int64, error) {
+	keys := []string{sessionKey(sessionId), userSessionsKey(userId)}
+	return rotateSessionScript.Run(ctx, Client, keys,
+		userId, oldRefreshId, newRefreshId, time.Now().Unix(), ip, int64(expire/time.Second)).Int64()
+}
+
+// DeleteSession 删除用户的一个登录会话
+func DeleteSession(ctx context.Context, userId int64, sessionId string) error {
+	_, err := Client.TxPipelined(ctx, func(pipe redis2.Pipeliner) error {
+		pipe.Del(ctx, sessionKey(sessionId))
+		pipe.SRem(ctx, userSessionsKey(userId), sessionId)
+		return nil
+	})
+	return err
+}
+
+// DeleteUserSessions 删除用户所有的登录会话
+func DeleteUserSessions(ctx context.Context, userId int64) error {
+	sessionIds, err := Client.SMembers(ctx, userSessionsKey(userId)).Result()
+	if err != nil {
+		return err
+	}
+	_, err = Client.TxPipelined(ctx, func(pipe redis2.Pipeliner) error {
+		for _, sessionId := range sessionIds {
+			pipe.Del(ctx, sessionKey(sessionId))
+		}
+		pipe.Del(ctx, userSessionsKey(userId))
+		return nil
+	})
+	return err
+}
+
+// ListSessions 获取用户未过期的登录会话，并清理已过期的会话id
+func ListSessions(ctx context.Context, userId int64) ([]*models.Session, error) {
+	sessionIds, err := Client.SMembers(ctx, userSessionsKey(userId)).Result()
+	if err != nil {
+		return nil, err
+	}
+	cmds, err := Client.Pipelined(ctx, func(pipe redis2.Pipeliner) error {
+		for _, sessionId := range sessionIds {
+			pipe.HGetAll(ctx, sessionKey(sessionId))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sessions := make([]*models.Session, 0, len(sessionIds))
+	var expired []interface{}
+	for i, cmd := range cmds {
+		result := cmd.(*redis2.MapStringStringCmd)
+		if len(result.Val()) == 0 {
+			expired = append(expired, sessionIds[i])
+			continue
+		}
+		session := new(models.Session)
+		if err := result.Scan(session); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	if len(expired) > 0 {
+		if err := Client.SRem(ctx, userSessionsKey(userId), expired...).Err(); err != nil {
+			return nil, err
+		}
+	}
+	return sessions, nil
+}
@@ -0,0 +1,146 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	redis2 "github.com/redis/go-redis/v9"
+	"strconv"
+	"time"
+)
+
+// 关注流收件箱，按帖子id(雪花id,随时间递增)排序
+const (
+	timelineBigVKey = "Timeline_BigV"
+	timelineExpire  = 7 * 24 * time.Hour
+)
+
+func timelineKey(userId int64) string {
+	return fmt.Sprintf("Timeline:%d", userId)
+}
+
+// PushTimeline 将帖子推送到粉丝的收件箱中，并将收件箱长度裁剪到capLen
+func PushTimeline(ctx context.Context, fansIds []int64, postId int64, capLen int64) error {
+	_, err := Client.Pipelined(ctx, func(pipe redis2.Pipeliner) error {
+		for _, fansId := range fansIds {
+			key := timelineKey(fansId)
+			pipe.ZAdd(ctx, key, redis2.Z{
+				Member: postId,
+				Score:  float64(postId),
+			})
+			pipe.ZRemRangeByRank(ctx, key, 0, -capLen-1)
+			pipe.Expire(ctx, key, timelineExpire)
+		}
+		return nil
+	})
+	return err
+}
+
+// BackfillTimeline 关注后将被关注者最近的帖子补入收件箱
+func BackfillTimeline(ctx context.Context, userId int64, postIds []int64, capLen int64) error {
+	if len(postIds) == 0 {
+		return nil
+	}
+	key := timelineKey(userId)
+	_, err := Client.TxPipelined(ctx, func(pipe redis2.Pipeliner) error {
+		members := make([]redis2.Z, len(postIds))
+		for i, postId := range postIds {
+			members[i] = redis2.Z{
+				Member: postId,
+				Score:  float64(postId),
+			}
+		}
+		pipe.ZAdd(ctx, key, members...)
+		pipe.ZRemRangeByRank(ctx, key, 0, -capLen-1)
+		pipe.Expire(ctx, key, timelineExpire)
+		return nil
+	})
+	return err
+}
+
+// PruneTimeline 取消关注后将被取关者的帖子从收件箱移除
+func PruneTimeline(ctx context.Context, userId int64, postIds []int64) error {
+	if len(postIds) == 0 {
+		return nil
+	}
+	members := make([]interface{}, len(postIds))
+	for i, postId := range postIds {
+		members[i] = postId
+	}
+	return Client.ZRem(ctx, timelineKey(userId), members...).Err()
+}
+
+// RemoveFromTimeline 将帖子从多个用户的收件箱中移除
+func RemoveFromTimeline(ctx context.Context, userIds []int64, postId int64) error {
+	_, err := Client.Pipelined(ctx, func(pipe redis2.Pipeliner) error {
+		for _, userId := range userIds {
+			pipe.ZRem(ctx, timelineKey(userId), postId)
+		}
+		return nil
+	})
+	return err
+}
+
+// TimelineExists 判断用户收件箱是否存在
+func TimelineExists(ctx context.Context, userId int64) (bool, error) {
+	n, err := Client.Exists(ctx, timelineKey(userId)).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// GetTimeline 获取收件箱中id小于lastPostId的帖子id，按id倒序
+func GetTimeline(ctx context.Context, userId int64, lastPostId int64, limit int64) ([]int64, error) {
+	postIdsStr, err := Client.ZRevRangeByScore(ctx, timelineKey(userId), &redis2.ZRangeBy{
+		Max:   fmt.Sprintf("(%d", lastPostId),
+		Min:   "-inf",
+		Count: limit,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	postIds := make([]int64, 0, len(postIdsStr))
+	for _, postIdStr := range postIdsStr {
+		postId, err := strconv.ParseInt(postIdStr, 10, 64)
+		if err != nil {
+			continue
+		}
+		postIds = append(postIds, postId)
+	}
+	return postIds, nil
+}
+
+// MarkBigV 标记或取消标记粉丝数超过推送阈值的用户，这些用户的帖子在读取时拉取
+func MarkBigV(ctx context.Context, userId int64, isBigV bool) error {
+	if isBigV {
+		return Client.SAdd(ctx, timelineBigVKey, userId).Err()
+	}
+	return Client.SRem(ctx, timelineBigVKey, userId).Err()
+}
+
+// IsBigV 判断用户是否为大V
+func IsBigV(ctx context.Context, userId int64) (bool, error) {
+	return Client.SIsMember(ctx, timelineBigVKey, userId).Result()
+}
+
+// FilterBigV 从用户id中筛选出大V
+func FilterBigV(ctx context.Context, userIds []int64) ([]int64, error) {
+	if len(userIds) == 0 {
+		return nil, nil
+	}
+	members := make([]interface{}, len(userIds))
+	for i, userId := range userIds {
+		members[i] = userId
+	}
+	results, err := Client.SMIsMember(ctx, timelineBigVKey, members...).Result()
+	if err != nil {
+		return nil, err
+	}
+	var bigV []int64
+	for i, ok := range results {
+		if ok {
+			bigV = append(bigV, userIds[i])
+		}
+	}
+	return bigV, nil
+}
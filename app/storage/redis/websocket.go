@@ -0,0 +1,97 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	redis2 "github.com/redis/go-redis/v9"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 用户的websocket连接表，field为连接id，value为"实例id|最后续期时间"
+// 实例异常退出时连接不会被删除，超过wsConnExpire未续期的连接视为已断开
+const wsConnExpire = 2 * time.Minute
+
+func wsConnKey(userId int64) string {
+	return fmt.Sprintf("WebsocketConn:%d", userId)
+}
+
+// PrivateChatChannel 消息服务实例的私信投递频道
+func PrivateChatChannel(instanceId string) string {
+	return "PrivateChat:" + instanceId
+}
+
+// SaveWsConn 记录连接所在的消息服务实例，定时重复调用以续期
+func SaveWsConn(ctx context.Context, userId int64, connId string, instanceId string) error {
+	key := wsConnKey(userId)
+	value := instanceId + "|" + strconv.FormatInt(time.Now().Unix(), 10)
+	_, err := Client.TxPipelined(ctx, func(pipe redis2.Pipeliner) error {
+		pipe.HSet(ctx, key, connId, value)
+		pipe.Expire(ctx, key, wsConnExpire)
+		return nil
+	})
+	return err
+}
+
+// RemoveWsConn 删除断开的连接
+func RemoveWsConn(ctx context.Context, userId int64, connId string) error {
+	return Client.HDel(ctx, wsConnKey(userId), connId).Err()
+}
+
+// GetWsInstances 获取用户在线连接所在的实例，并清理超时未续期的连接
+func GetWsInstances(ctx context.Context, userId int64) ([]string, error) {
+	key := wsConnKey(userId)
+	conns, err := Client.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+	deadline := time.Now().Add(-wsConnExpire).Unix()
+	instances := make([]string, 0, len(conns))
+	seen := make(map[string]struct{}, len(conns))
+	var expired []string
+	for connId, value := range conns {
+		instanceId, lastSeenStr, ok := strings.Cut(value, "|")
+		lastSeen, err := strconv.ParseInt(lastSeenStr, 10, 64)
+		if !ok || err != nil || lastSeen < deadline {
+			expired = append(expired, connId)
+			continue
+		}
+		if _, ok := seen[instanceId]; ok {
+			continue
+		}
+		seen[instanceId] = struct{}{}
+		instances = append(instances, instanceId)
+	}
+	if len(expired) > 0 {
+		Client.HDel(ctx, key, expired...)
+	}
+	return instances, nil
+}
+
+// PublishToInstance 将消息投递到指定消息服务实例
+func PublishToInstance(ctx context.Context, instanceId string, payload []byte) error {
+	return Client.Publish(ctx, PrivateChatChannel(instanceId), payload).Err()
+}
+
+// 客户端消息id到服务端消息id的映射，客户端超时重发时据此去重
+const clientMsgExpire = 10 * time.Minute
+
+func clientMsgKey(userId int64, clientMsgId string) string {
+	return fmt.Sprintf("ClientMsg:%d:%s", userId, clientMsgId)
+}
+
+// GetClientMsg 获取客户端消息id对应的已发送消息id，未发送过时返回0
+func GetClientMsg(ctx context.Context, userId int64, clientMsgId string) (int64, error) {
+	messageId, err := Client.Get(ctx, clientMsgKey(userId, clientMsgId)).Int64()
+	if errors.Is(err, redis2.Nil) {
+		return 0, nil
+	}
+	return messageId, err
+}
+
+// SaveClientMsg 记录客户端消息id对应的已发送消息id
+func SaveClientMsg(ctx context.Context, userId int64, clientMsgId string, messageId int64) error {
+	return Client.Set(ctx, clientMsgKey(userId, clientMsgId), messageId, clientMsgExpire).Err()
+}
@@ -0,0 +1,227 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"star/app/constant/settings"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// 支持的签名算法
+const (
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+var (
+	errNoSigningKey = errors.New("jwt signing key not configured")
+	errUnknownKid   = errors.New("jwt kid not found")
+)
+
+// signingKey 一个签名密钥，private为空时只能验证token
+type signingKey struct {
+	kid     string
+	method  jwt.SigningMethod
+	private crypto.PrivateKey
+	public  crypto.PublicKey
+}
+
+// keySet 签发token的密钥和所有用于验证的密钥
+type keySet struct {
+	signing *signingKey
+	verify  map[string]*signingKey
+	kids    []string //按配置顺序，用于生成jwks
+}
+
+var (
+	keysMu sync.RWMutex
+	keys   *keySet
+)
+
+// LoadKeys 从配置重新加载密钥，修改密钥配置后调用
+func LoadKeys() error {
+	set, err := newKeySet(settings.Conf.JwtConfig)
+	if err != nil {
+		return err
+	}
+	keysMu.Lock()
+	keys = set
+	keysMu.Unlock()
+	return nil
+}
+
+// getKeys 获取密钥，第一次使用时从配置加载
+func getKeys() (*keySet, error) {
+	keysMu.RLock()
+	set := keys
+	keysMu.RUnlock()
+	if set != nil {
+		return set, nil
+	}
+	if err := LoadKeys(); err != nil {
+		return nil, err
+	}
+	keysMu.RLock()
+	defer keysMu.RUnlock()
+	return keys, nil
+}
+
+func newKeySet(conf *settings.JwtConfig) (*keySet, error) {
+	set := &keySet{verify: make(map[string]*signingKey)}
+	if conf == nil {
+		return set, nil
+	}
+	for i := range conf.Keys {
+		key, err := loadKey(&conf.Keys[i])
+		if err != nil {
+			return nil, fmt.Errorf("load jwt key %q: %w", conf.Keys[i].Kid, err)
+		}
+		if _, ok := set.verify[key.kid]; ok {
+			return nil, fmt.Errorf("duplicate jwt kid %q", key.kid)
+		}
+		set.verify[key.kid] = key
+		set.kids = append(set.kids, key.kid)
+	}
+	if conf.SigningKid != "" {
+		key, ok := set.verify[conf.SigningKid]
+		if !ok {
+			return nil, fmt.Errorf("jwt signing kid %q: %w", conf.SigningKid, errUnknownKid)
+		}
+		if key.private == nil {
+			return nil, fmt.Errorf("jwt signing kid %q has no private key", conf.SigningKid)
+		}
+		set.signing = key
+	}
+	return set, nil
+}
+
+// loadKey 解析PEM格式的密钥，只配置私钥时由私钥得到公钥
+func loadKey(conf *settings.JwtKey) (*signingKey, error) {
+	if conf.Kid == "" {
+		return nil, errors.New("empty kid")
+	}
+	privatePEM, err := readPEM(conf.PrivateKey, conf.PrivateKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	publicPEM, err := readPEM(conf.PublicKey, conf.PublicKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	key := &signingKey{kid: conf.Kid}
+	switch conf.Alg {
+	case AlgRS256:
+		key.method = jwt.SigningMethodRS256
+		if privatePEM != nil {
+			private, err := jwt.ParseRSAPrivateKeyFromPEM(privatePEM)
+			if err != nil {
+				return nil, err
+			}
+			key.private, key.public = private, &private.PublicKey
+		}
+		if publicPEM != nil {
+			if key.public, err = jwt.ParseRSAPublicKeyFromPEM(publicPEM); err != nil {
+				return nil, err
+			}
+		}
+	case AlgEdDSA:
+		key.method = jwt.SigningMethodEdDSA
+		if privatePEM != nil {
+			private, err := jwt.ParseEdPrivateKeyFromPEM(privatePEM)
+			if err != nil {
+				return nil, err
+			}
+			key.private, key.public = private, private.(ed25519.PrivateKey).Public()
+		}
+		if publicPEM != nil {
+			if key.public, err = jwt.ParseEdPublicKeyFromPEM(publicPEM); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported alg %q", conf.Alg)
+	}
+	if key.public == nil {
+		return nil, errors.New("no key configured")
+	}
+	return key, nil
+}
+
+// readPEM 优先使用配置中的PEM，为空时读取文件，都为空时返回nil
+func readPEM(value string, file string) ([]byte, error) {
+	if value != "" {
+		return []byte(value), nil
+	}
+	if file != "" {
+		return os.ReadFile(file)
+	}
+	return nil, nil
+}
+
+// keyFunc 按token头部的kid选择验证密钥，算法必须与密钥一致
+func (s *keySet) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := s.verify[kid]
+	if !ok {
+		return nil, errUnknownKid
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("unexpected alg %q for kid %q", token.Method.Alg(), kid)
+	}
+	return key.public, nil
+}
+
+// sign 使用当前的签名密钥签发token
+func (s *keySet) sign(claims jwt.Claims) (string, error) {
+	if s.signing == nil {
+		return "", errNoSigningKey
+	}
+	token := jwt.NewWithClaims(s.signing.method, claims)
+	token.Header["kid"] = s.signing.kid
+	return token.SignedString(s.signing.private)
+}
+
+// JWK 公钥的JSON Web Key表示
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`   //RSA模数
+	E   string `json:"e,omitempty"`   //RSA指数
+	Crv string `json:"crv,omitempty"` //Ed25519
+	X   string `json:"x,omitempty"`   //Ed25519公钥
+}
+
+// JWKS 获取所有验证密钥的公钥，供其他服务验证token
+func JWKS() ([]JWK, error) {
+	set, err := getKeys()
+	if err != nil {
+		return nil, err
+	}
+	jwks := make([]JWK, 0, len(set.kids))
+	for _, kid := range set.kids {
+		key := set.verify[kid]
+		jwk := JWK{Kid: kid, Use: "sig", Alg: key.method.Alg()}
+		switch public := key.public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(public)
+		}
+		jwks = append(jwks, jwk)
+	}
+	return jwks, nil
+}
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"go.uber.org/zap"
 	"star/app/constant/settings"
-	"star/app/models"
 	"star/app/utils/logging"
 	"time"
 
@@ -14,73 +13,122 @@ import (
 // token过期时间
 const (
 	expireAccessToken  = 10 * time.Hour
-	expireRefreshToken = 7 * 24 * time.Hour
+	ExpireRefreshToken = 7 * 24 * time.Hour //登录会话随refresh token过期
 )
 
-// MyClaims token配置结构体
+// token类型，refresh token只能用来刷新，不能访问接口
+const (
+	AccessTokenType  = "access"
+	RefreshTokenType = "refresh"
+)
+
+// token的受众，用户token和管理员token不能互相使用
+const (
+	UserAudience  = "star-user"
+	AdminAudience = "star-admin"
+)
+
+// MyClaims token配置结构体，refresh token的ID为会话当前的refresh token id
 type MyClaims struct {
-	UserID int64 `json:"userId"`
+	UserID    int64  `json:"userId"`
+	SessionId string `json:"sid,omitempty"` //登录会话id
+	Type      string `json:"typ"`
 	jwt.RegisteredClaims
 }
 
-// 密钥
-var key = []byte("冯宇萌")
 var (
 	tokenExpired = errors.New("jwtAuth is expired")
 	tokenInValid = errors.New("jwtAuth invalid")
 )
 
-// GetToken 获取token
-func GetToken(user *models.User) (accessTokenString string, refreshTokenString string, err error) {
+// GetToken 获取登录会话的accessToken和refreshToken
+func GetToken(userId int64, sessionId string, refreshId string) (accessTokenString string, refreshTokenString string, err error) {
 	//获取accessToken
-	accessTokenString, err = generateToken(user.UserId, expireAccessToken)
+	accessTokenString, err = GetAccessToken(userId, sessionId)
 	if err != nil {
 		return "", "", err
 	}
 	//获取refreshToken
-	refreshTokenString, err = generateToken(0, expireRefreshToken)
+	refreshTokenString, err = generateToken(&MyClaims{
+		UserID:           userId,
+		SessionId:        sessionId,
+		Type:             RefreshTokenType,
+		RegisteredClaims: jwt.RegisteredClaims{ID: refreshId},
+	}, UserAudience, ExpireRefreshToken)
 	if err != nil {
 		return "", "", err
 	}
 	return
 }
 
-// RefreshAccessToken 刷新accessToken
-func RefreshAccessToken(accessToken, refreshToken string) (string, error) {
-	//判断refresh token是否合法
-	if _, err := ParseToken(refreshToken); err != nil {
-		return "", err
+// GetAccessToken 获取用户登录会话的accessToken
+func GetAccessToken(userId int64, sessionId string) (string, error) {
+	return generateToken(&MyClaims{
+		UserID:    userId,
+		SessionId: sessionId,
+		Type:      AccessTokenType,
+	}, UserAudience, expireAccessToken)
+}
+
+// GetAdminToken 获取管理员token，管理员token不属于用户的登录会话
+func GetAdminToken(adminId int64) (string, error) {
+	return generateToken(&MyClaims{
+		UserID: adminId,
+		Type:   AccessTokenType,
+	}, AdminAudience, expireAccessToken)
+}
+
+// ParseAccessToken 解析用户的accessToken
+func ParseAccessToken(tokenString string) (*MyClaims, error) {
+	claims, err := parseToken(tokenString, UserAudience)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Type != AccessTokenType {
+		return nil, tokenInValid
+	}
+	return claims, nil
+}
+
+// ParseAdminToken 解析管理员token
+func ParseAdminToken(tokenString string) (*MyClaims, error) {
+	claims, err := parseToken(tokenString, AdminAudience)
+	if err != nil {
+		return nil, err
 	}
-	//判断access jwtAuth 是否是因为过期而失效并解析出负载信息
-	claims, err := ParseToken(accessToken)
-	if !errors.Is(err, tokenExpired) {
-		return "", tokenInValid
+	if claims.Type != AccessTokenType {
+		return nil, tokenInValid
 	}
-	return generateToken(claims.UserID, expireAccessToken)
+	return claims, nil
 }
 
-// AutoLogin 刷新accessToken并且返回userId
-func AutoLogin(accessToken, refreshToken string) (int64, string, error) {
-	//判断refresh token是否合法
-	if _, err := ParseToken(refreshToken); err != nil {
-		return 0, "", err
+// ParseRefreshToken 解析refreshToken
+func ParseRefreshToken(tokenString string) (*MyClaims, error) {
+	claims, err := parseToken(tokenString, UserAudience)
+	if err != nil {
+		return nil, err
 	}
-	//判断access jwtAuth 是否是因为过期而失效并解析出负载信息
-	claims, err := ParseToken(accessToken)
-	if err != nil && !errors.Is(err, tokenExpired) {
-		return 0, "", tokenInValid
+	if claims.Type != RefreshTokenType || claims.SessionId == "" || claims.ID == "" {
+		return nil, tokenInValid
 	}
-	newAccessToken, err := generateToken(claims.UserID, expireAccessToken)
-	return claims.UserID, newAccessToken, err
+	return claims, nil
 }
 
-// ParseToken 解析token
-func ParseToken(tokenString string) (*MyClaims, error) {
-	//基于密钥解析token
+// parseToken 解析token，按kid选择公钥验证签名并校验受众
+func parseToken(tokenString string, audience string) (*MyClaims, error) {
+	set, err := getKeys()
+	if err != nil {
+		logging.Logger.Error("load jwt keys error",
+			zap.Error(err))
+		return nil, tokenInValid
+	}
 	claims := new(MyClaims)
-	jwtToken, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		return key, nil
-	})
+	jwtToken, err := jwt.ParseWithClaims(tokenString, claims, set.keyFunc,
+		jwt.WithAudience(audience),
+		jwt.WithValidMethods([]string{AlgRS256, AlgEdDSA}))
+	if errors.Is(err, jwt.ErrTokenExpired) {
+		return nil, tokenExpired
+	}
 	if err != nil {
 		logging.Logger.Error("invalid token,err:",
 			zap.Error(err))
@@ -96,17 +144,15 @@ func ParseToken(tokenString string) (*MyClaims, error) {
 }
 
 // generateToken 生成JWT token
-func generateToken(userID int64, expiration time.Duration) (string, error) {
-	claims := &MyClaims{
-		UserID: userID,
-		RegisteredClaims: jwt.RegisteredClaims{
-			Issuer:    settings.Conf.AliyunConfig.SignName,            //发行人
-			IssuedAt:  jwt.NewNumericDate(time.Now()),                 //发行时间
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiration)), //过期时间
-		},
+func generateToken(claims *MyClaims, audience string, expiration time.Duration) (string, error) {
+	set, err := getKeys()
+	if err != nil {
+		return "", err
 	}
-	//使用jwt签名算法生成token
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	//将token进行盐加密
-	return token.SignedString(key)
+	claims.Issuer = settings.Conf.AliyunConfig.SignName               //发行人
+	claims.Audience = jwt.ClaimStrings{audience}                      //受众
+	claims.IssuedAt = jwt.NewNumericDate(time.Now())                  //发行时间
+	claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(expiration)) //过期时间
+	//使用当前的签名密钥签名，头部带有kid
+	return set.sign(claims)
 }
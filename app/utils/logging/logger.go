@@ -19,6 +19,11 @@ var hostname string
 // 初始化Logger
 func init() {
 	hostname, _ = os.Hostname()
+	//未读取到配置文件时（如单元测试）输出到标准错误
+	if settings.Conf.LogConfig == nil {
+		Logger = zap.New(zapcore.NewCore(getEncoder(), zapcore.AddSync(os.Stderr), zapcore.InfoLevel), zap.AddCaller())
+		return
+	}
 	writeSyncer := getLogWriter(settings.Conf.FileName, settings.Conf.MaxSize, settings.Conf.MaxBackups, settings.Conf.MaxAge)
 	encoder := getEncoder()
 	var l = new(zapcore.Level)
@@ -0,0 +1,106 @@
+package mention
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"regexp"
+	"star/proto/message/messagePb"
+	"star/proto/user/userPb"
+	"unicode"
+)
+
+const (
+	MaxMentions   = 10  //单条帖子或评论最多提醒的用户数，防止刷屏
+	ExcerptLength = 255 //提醒中保存的内容长度，与提醒表content长度一致
+)
+
+// @前面必须是开头或非用户名字符，避免把邮箱识别为提及
+var mentionRegex = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_])@([\p{L}\p{N}_-]{1,20})`)
+
+// Parse 解析内容中的@用户名，按出现顺序去重，最多返回MaxMentions个
+func Parse(content string) []string {
+	matches := mentionRegex.FindAllStringSubmatch(content, -1)
+	usernames := make([]string, 0, min(len(matches), MaxMentions))
+	seen := make(map[string]struct{}, len(matches))
+	for _, match := range matches {
+		username := match[1]
+		//用户名不能以数字开头
+		if unicode.IsDigit([]rune(username)[0]) {
+			continue
+		}
+		if _, ok := seen[username]; ok {
+			continue
+		}
+		seen[username] = struct{}{}
+		usernames = append(usernames, username)
+		if len(usernames) == MaxMentions {
+			break
+		}
+	}
+	return usernames
+}
+
+// Excerpt 截取提醒中保存或展示的内容，超出length个字符时截断并以...结尾
+func Excerpt(content string, length int) string {
+	runes := []rune(content)
+	if len(runes) <= length {
+		return content
+	}
+	return string(runes[:max(length-3, 0)]) + "..."
+}
+
+// Remind 向内容中@的用户发送提及提醒，sourceType为feed或comment，发送者@自己时不提醒
+func Remind(ctx context.Context, userService userPb.UserService, messageService messagePb.MessageService,
+	senderId int64, sourceType string, sourceId int64, content string, url string, logger *zap.Logger) {
+	send(ctx, userService, messageService, senderId, sourceType, sourceId, content, url, false, logger)
+}
+
+// Retract 内容被删除时撤回向其中@的用户发送的提及提醒
+func Retract(ctx context.Context, userService userPb.UserService, messageService messagePb.MessageService,
+	senderId int64, sourceType string, sourceId int64, content string, logger *zap.Logger) {
+	send(ctx, userService, messageService, senderId, sourceType, sourceId, content, "", true, logger)
+}
+
+// send 向内容中@的用户发送提及提醒，isDeleted为true时撤回提醒
+func send(ctx context.Context, userService userPb.UserService, messageService messagePb.MessageService,
+	senderId int64, sourceType string, sourceId int64, content string, url string, isDeleted bool, logger *zap.Logger) {
+	usernames := Parse(content)
+	if len(usernames) == 0 {
+		return
+	}
+	userResp, err := userService.GetUserIdsByUsername(ctx, &userPb.GetUserIdsByUsernameRequest{
+		Usernames: usernames,
+	})
+	if err != nil {
+		logger.Error("get mentioned user ids error",
+			zap.Error(err),
+			zap.String("sourceType", sourceType),
+			zap.Int64("sourceId", sourceId),
+			zap.Strings("usernames", usernames))
+		return
+	}
+	for _, username := range usernames {
+		userId, ok := userResp.UserIds[username]
+		if !ok || userId == senderId {
+			continue
+		}
+		_, err := messageService.SendRemindMessage(ctx, &messagePb.SendRemindMessageRequest{
+			SenderId:    senderId,
+			RecipientId: userId,
+			SourceId:    sourceId,
+			SourceType:  sourceType,
+			RemindType:  "mention",
+			Content:     Excerpt(content, ExcerptLength),
+			Url:         url,
+			IsDeleted:   isDeleted,
+		})
+		if err != nil {
+			logger.Error("send mention remind message error",
+				zap.Error(err),
+				zap.String("sourceType", sourceType),
+				zap.Int64("sourceId", sourceId),
+				zap.Int64("recipientId", userId),
+				zap.Bool("isDeleted", isDeleted))
+		}
+	}
+}
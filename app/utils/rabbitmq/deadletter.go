@@ -0,0 +1,64 @@
+package rabbitmq
+
+import (
+	"fmt"
+	"github.com/rabbitmq/amqp091-go"
+	"time"
+)
+
+// 重试和死信消息使用的header
+const (
+	HeaderRetryCount  = "x-retry-count"  //已重试次数
+	HeaderDelay       = "x-delay"        //延迟交换机的延迟时间，单位毫秒
+	HeaderAttempts    = "x-attempts"     //每次处理失败的时间和原因
+	HeaderDeadReason  = "x-dead-reason"  //进入死信队列的原因
+	HeaderDeadQueue   = "x-dead-queue"   //消息原来所在的队列
+	HeaderDeadAt      = "x-dead-at"      //进入死信队列的时间
+	HeaderReplayCount = "x-replay-count" //从死信队列重新投递的次数
+)
+
+// DeadLetterQueue 消息队列对应的死信队列
+func DeadLetterQueue(queue string) string {
+	return queue + "_Dead"
+}
+
+// CopyHeaders 复制消息的header，重新投递时保留链路追踪等信息
+func CopyHeaders(headers amqp091.Table) amqp091.Table {
+	table := make(amqp091.Table, len(headers)+3)
+	for k, v := range headers {
+		table[k] = v
+	}
+	return table
+}
+
+// RetryCount 获取消息已重试的次数
+func RetryCount(headers amqp091.Table) int32 {
+	switch count := headers[HeaderRetryCount].(type) {
+	case int32:
+		return count
+	case int64:
+		return int32(count)
+	}
+	return 0
+}
+
+// Attempts 获取消息的失败记录
+func Attempts(headers amqp091.Table) []string {
+	values, _ := headers[HeaderAttempts].([]interface{})
+	attempts := make([]string, 0, len(values))
+	for _, v := range values {
+		if attempt, ok := v.(string); ok {
+			attempts = append(attempts, attempt)
+		}
+	}
+	return attempts
+}
+
+// AppendAttempt 在消息的失败记录后追加本次失败的时间和原因
+func AppendAttempt(headers amqp091.Table, reason string) []interface{} {
+	values, _ := headers[HeaderAttempts].([]interface{})
+	attempts := make([]interface{}, len(values), len(values)+1)
+	copy(attempts, values)
+	attempt := fmt.Sprintf("%s %s", time.Now().UTC().Format(time.RFC3339), reason)
+	return append(attempts, attempt)
+}
@@ -0,0 +1,88 @@
+package rbac
+
+import (
+	"star/app/constant/settings"
+	"star/app/constant/str"
+	"star/app/models"
+	"star/app/storage/mysql"
+	"sync"
+	"time"
+)
+
+// 角色权限很少修改，缓存一段时间后重新从mysql加载
+const permissionsExpire = time.Minute
+
+var (
+	permissionsMu     sync.Mutex
+	permissions       []*models.RolePermission
+	permissionsLoaded time.Time
+)
+
+// rolePermissions 获取缓存的角色权限
+func rolePermissions() ([]*models.RolePermission, error) {
+	permissionsMu.Lock()
+	defer permissionsMu.Unlock()
+	if permissions != nil && time.Since(permissionsLoaded) < permissionsExpire {
+		return permissions, nil
+	}
+	loaded, err := mysql.GetRolePermissions()
+	if err != nil {
+		return nil, err
+	}
+	permissions, permissionsLoaded = loaded, time.Now()
+	return permissions, nil
+}
+
+// HasPermission 用户是否拥有权限，communityId为0时只看全局角色，否则同时看用户在该社区的角色
+func HasPermission(userId int64, permission string, communityId int64) (bool, error) {
+	if userId == 0 {
+		return false, nil
+	}
+	//配置的管理员为超级管理员，用于授予第一批角色
+	if settings.Conf.Admin != nil && userId == settings.Conf.Admin.Id {
+		return true, nil
+	}
+	roles, err := mysql.GetUserRoles(userId)
+	if err != nil {
+		return false, err
+	}
+	if len(roles) == 0 {
+		return false, nil
+	}
+	rolePerms, err := rolePermissions()
+	if err != nil {
+		return false, err
+	}
+	return Check(roles, rolePerms, permission, communityId), nil
+}
+
+// Authorize 用户没有权限时返回str.ErrPermissionDenied
+func Authorize(userId int64, permission string, communityId int64) error {
+	ok, err := HasPermission(userId, permission, communityId)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return str.ErrPermissionDenied
+	}
+	return nil
+}
+
+// Check 根据用户的角色和角色权限判断是否拥有权限，全局角色在所有社区有效，社区角色只在授予的社区有效
+func Check(roles []*models.UserRole, rolePerms []*models.RolePermission, permission string, communityId int64) bool {
+	granted := make(map[string]bool)
+	for _, perm := range rolePerms {
+		if perm.PermissionCode == permission || perm.PermissionCode == models.PermAll {
+			granted[perm.RoleCode] = true
+		}
+	}
+	for _, role := range roles {
+		if !granted[role.RoleCode] {
+			continue
+		}
+		if role.CommunityId == 0 || (communityId != 0 && role.CommunityId == communityId) {
+			return true
+		}
+	}
+	return false
+}
@@ -12,3 +12,12 @@ func GetUserId(c *gin.Context) (int64, error) {
 	}
 	return userId.(int64), nil
 }
+
+// GetSessionId 获取当前请求所属的登录会话
+func GetSessionId(c *gin.Context) (string, error) {
+	sessionId, ok := c.Get("sessionId")
+	if !ok {
+		return "", str.ErrNotLogin
+	}
+	return sessionId.(string), nil
+}
@@ -40,6 +40,12 @@ type AdminService interface {
 	DelCategory(ctx context.Context, in *DelCategoryRequest, opts ...client.CallOption) (*DelCategoryResponse, error)
 	SaveCategory(ctx context.Context, in *SaveCategoryRequest, opts ...client.CallOption) (*SaveCategoryResponse, error)
 	ChangeSort(ctx context.Context, in *ChangeSortRequest, opts ...client.CallOption) (*ChangeSortResponse, error)
+	//判断用户是否拥有全部权限，communityId不为0时同时看用户在该社区的角色
+	CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...client.CallOption) (*CheckPermissionResponse, error)
+	//授予或撤销角色，社区角色需要指定communityId
+	GrantRole(ctx context.Context, in *GrantRoleRequest, opts ...client.CallOption) (*GrantRoleResponse, error)
+	RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...client.CallOption) (*RevokeRoleResponse, error)
+	ListUserRoles(ctx context.Context, in *ListUserRolesRequest, opts ...client.CallOption) (*ListUserRolesResponse, error)
 }
 
 type adminService struct {
@@ -94,6 +100,46 @@ func (c *adminService) ChangeSort(ctx context.Context, in *ChangeSortRequest, op
 	return out, nil
 }
 
+func (c *adminService) CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...client.CallOption) (*CheckPermissionResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.CheckPermission", in)
+	out := new(CheckPermissionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) GrantRole(ctx context.Context, in *GrantRoleRequest, opts ...client.CallOption) (*GrantRoleResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.GrantRole", in)
+	out := new(GrantRoleResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...client.CallOption) (*RevokeRoleResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.RevokeRole", in)
+	out := new(RevokeRoleResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) ListUserRoles(ctx context.Context, in *ListUserRolesRequest, opts ...client.CallOption) (*ListUserRolesResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.ListUserRoles", in)
+	out := new(ListUserRolesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AdminService service
 
 type AdminServiceHandler interface {
@@ -101,6 +147,12 @@ type AdminServiceHandler interface {
 	DelCategory(context.Context, *DelCategoryRequest, *DelCategoryResponse) error
 	SaveCategory(context.Context, *SaveCategoryRequest, *SaveCategoryResponse) error
 	ChangeSort(context.Context, *ChangeSortRequest, *ChangeSortResponse) error
+	//判断用户是否拥有全部权限，communityId不为0时同时看用户在该社区的角色
+	CheckPermission(context.Context, *CheckPermissionRequest, *CheckPermissionResponse) error
+	//授予或撤销角色，社区角色需要指定communityId
+	GrantRole(context.Context, *GrantRoleRequest, *GrantRoleResponse) error
+	RevokeRole(context.Context, *RevokeRoleRequest, *RevokeRoleResponse) error
+	ListUserRoles(context.Context, *ListUserRolesRequest, *ListUserRolesResponse) error
 }
 
 func RegisterAdminServiceHandler(s server.Server, hdlr AdminServiceHandler, opts ...server.HandlerOption) error {
@@ -109,6 +161,10 @@ func RegisterAdminServiceHandler(s server.Server, hdlr AdminServiceHandler, opts
 		DelCategory(ctx context.Context, in *DelCategoryRequest, out *DelCategoryResponse) error
 		SaveCategory(ctx context.Context, in *SaveCategoryRequest, out *SaveCategoryResponse) error
 		ChangeSort(ctx context.Context, in *ChangeSortRequest, out *ChangeSortResponse) error
+		CheckPermission(ctx context.Context, in *CheckPermissionRequest, out *CheckPermissionResponse) error
+		GrantRole(ctx context.Context, in *GrantRoleRequest, out *GrantRoleResponse) error
+		RevokeRole(ctx context.Context, in *RevokeRoleRequest, out *RevokeRoleResponse) error
+		ListUserRoles(ctx context.Context, in *ListUserRolesRequest, out *ListUserRolesResponse) error
 	}
 	type AdminService struct {
 		adminService
@@ -136,3 +192,19 @@ func (h *adminServiceHandler) SaveCategory(ctx context.Context, in *SaveCategory
 func (h *adminServiceHandler) ChangeSort(ctx context.Context, in *ChangeSortRequest, out *ChangeSortResponse) error {
 	return h.AdminServiceHandler.ChangeSort(ctx, in, out)
 }
+
+func (h *adminServiceHandler) CheckPermission(ctx context.Context, in *CheckPermissionRequest, out *CheckPermissionResponse) error {
+	return h.AdminServiceHandler.CheckPermission(ctx, in, out)
+}
+
+func (h *adminServiceHandler) GrantRole(ctx context.Context, in *GrantRoleRequest, out *GrantRoleResponse) error {
+	return h.AdminServiceHandler.GrantRole(ctx, in, out)
+}
+
+func (h *adminServiceHandler) RevokeRole(ctx context.Context, in *RevokeRoleRequest, out *RevokeRoleResponse) error {
+	return h.AdminServiceHandler.RevokeRole(ctx, in, out)
+}
+
+func (h *adminServiceHandler) ListUserRoles(ctx context.Context, in *ListUserRolesRequest, out *ListUserRolesResponse) error {
+	return h.AdminServiceHandler.ListUserRoles(ctx, in, out)
+}
@@ -42,6 +42,8 @@ type CommentService interface {
 	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...client.CallOption) (*DeleteCommentResponse, error)
 	// 获取评论服务
 	GetComments(ctx context.Context, in *GetCommentsRequest, opts ...client.CallOption) (*GetCommentsResponse, error)
+	// 分页获取评论的回复
+	GetReplies(ctx context.Context, in *GetRepliesRequest, opts ...client.CallOption) (*GetRepliesResponse, error)
 	CountComment(ctx context.Context, in *CountCommentRequest, opts ...client.CallOption) (*CountCommentResponse, error)
 	QueryCommentExist(ctx context.Context, in *QueryCommentExistRequest, opts ...client.CallOption) (*QueryCommentExistResponse, error)
 }
@@ -88,6 +90,16 @@ func (c *commentService) GetComments(ctx context.Context, in *GetCommentsRequest
 	return out, nil
 }
 
+func (c *commentService) GetReplies(ctx context.Context, in *GetRepliesRequest, opts ...client.CallOption) (*GetRepliesResponse, error) {
+	req := c.c.NewRequest(c.name, "CommentService.GetReplies", in)
+	out := new(GetRepliesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *commentService) CountComment(ctx context.Context, in *CountCommentRequest, opts ...client.CallOption) (*CountCommentResponse, error) {
 	req := c.c.NewRequest(c.name, "CommentService.CountComment", in)
 	out := new(CountCommentResponse)
@@ -117,6 +129,8 @@ type CommentServiceHandler interface {
 	DeleteComment(context.Context, *DeleteCommentRequest, *DeleteCommentResponse) error
 	// 获取评论服务
 	GetComments(context.Context, *GetCommentsRequest, *GetCommentsResponse) error
+	// 分页获取评论的回复
+	GetReplies(context.Context, *GetRepliesRequest, *GetRepliesResponse) error
 	CountComment(context.Context, *CountCommentRequest, *CountCommentResponse) error
 	QueryCommentExist(context.Context, *QueryCommentExistRequest, *QueryCommentExistResponse) error
 }
@@ -126,6 +140,7 @@ func RegisterCommentServiceHandler(s server.Server, hdlr CommentServiceHandler,
 		PostComment(ctx context.Context, in *PostCommentRequest, out *PostCommentResponse) error
 		DeleteComment(ctx context.Context, in *DeleteCommentRequest, out *DeleteCommentResponse) error
 		GetComments(ctx context.Context, in *GetCommentsRequest, out *GetCommentsResponse) error
+		GetReplies(ctx context.Context, in *GetRepliesRequest, out *GetRepliesResponse) error
 		CountComment(ctx context.Context, in *CountCommentRequest, out *CountCommentResponse) error
 		QueryCommentExist(ctx context.Context, in *QueryCommentExistRequest, out *QueryCommentExistResponse) error
 	}
@@ -152,6 +167,10 @@ func (h *commentServiceHandler) GetComments(ctx context.Context, in *GetComments
 	return h.CommentServiceHandler.GetComments(ctx, in, out)
 }
 
+func (h *commentServiceHandler) GetReplies(ctx context.Context, in *GetRepliesRequest, out *GetRepliesResponse) error {
+	return h.CommentServiceHandler.GetReplies(ctx, in, out)
+}
+
 func (h *commentServiceHandler) CountComment(ctx context.Context, in *CountCommentRequest, out *CountCommentResponse) error {
 	return h.CommentServiceHandler.CountComment(ctx, in, out)
 }
@@ -10,7 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
-	postPb "star/proto/feed/feedPb"
+	feedPb "star/proto/feed/feedPb"
 	sync "sync"
 )
 
@@ -284,7 +284,7 @@ type LikeListResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Posts []*postPb.Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	Posts []*feedPb.Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
 }
 
 func (x *LikeListResponse) Reset() {
@@ -319,7 +319,7 @@ func (*LikeListResponse) Descriptor() ([]byte, []int) {
 	return file_like_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *LikeListResponse) GetPosts() []*postPb.Post {
+func (x *LikeListResponse) GetPosts() []*feedPb.Post {
 	if x != nil {
 		return x.Posts
 	}
@@ -632,12 +632,328 @@ func (x *GetUserLikeCountResponse) GetCount() int64 {
 	return 0
 }
 
+type ReconcileFavorCountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind      string  `protobuf:"bytes,1,opt,name=Kind,proto3" json:"Kind,omitempty"`                   //post_like 帖子点赞数  comment_like 评论点赞数  post_collect 帖子收藏数，为空时全部
+	SourceIds []int64 `protobuf:"varint,2,rep,packed,name=SourceIds,proto3" json:"SourceIds,omitempty"` //只对账指定的帖子或评论
+	Full      bool    `protobuf:"varint,3,opt,name=Full,proto3" json:"Full,omitempty"`                  //true 按id顺序全量对账  false 只对账最近计数有变化的id
+	Cursor    int64   `protobuf:"varint,4,opt,name=Cursor,proto3" json:"Cursor,omitempty"`              //全量对账的起始id
+	Limit     int64   `protobuf:"varint,5,opt,name=Limit,proto3" json:"Limit,omitempty"`                //每种计数最多对账的id数
+	Repair    string  `protobuf:"bytes,6,opt,name=Repair,proto3" json:"Repair,omitempty"`               //为空只报告不修复  mysql 修复数据库  redis 修复缓存  all 都修复
+}
+
+func (x *ReconcileFavorCountRequest) Reset() {
+	*x = ReconcileFavorCountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_like_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcileFavorCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileFavorCountRequest) ProtoMessage() {}
+
+func (x *ReconcileFavorCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_like_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileFavorCountRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileFavorCountRequest) Descriptor() ([]byte, []int) {
+	return file_like_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReconcileFavorCountRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReconcileFavorCountRequest) GetSourceIds() []int64 {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+func (x *ReconcileFavorCountRequest) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+func (x *ReconcileFavorCountRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ReconcileFavorCountRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ReconcileFavorCountRequest) GetRepair() string {
+	if x != nil {
+		return x.Repair
+	}
+	return ""
+}
+
+type FavorDrift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceId     int64 `protobuf:"varint,1,opt,name=SourceId,proto3" json:"SourceId,omitempty"`
+	Expected     int64 `protobuf:"varint,2,opt,name=Expected,proto3" json:"Expected,omitempty"` //从点赞、收藏记录统计的计数
+	MysqlCount   int64 `protobuf:"varint,3,opt,name=MysqlCount,proto3" json:"MysqlCount,omitempty"`
+	RedisCount   int64 `protobuf:"varint,4,opt,name=RedisCount,proto3" json:"RedisCount,omitempty"`
+	RedisMissing bool  `protobuf:"varint,5,opt,name=RedisMissing,proto3" json:"RedisMissing,omitempty"` //缓存中没有计数
+}
+
+func (x *FavorDrift) Reset() {
+	*x = FavorDrift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_like_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FavorDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavorDrift) ProtoMessage() {}
+
+func (x *FavorDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_like_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavorDrift.ProtoReflect.Descriptor instead.
+func (*FavorDrift) Descriptor() ([]byte, []int) {
+	return file_like_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FavorDrift) GetSourceId() int64 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *FavorDrift) GetExpected() int64 {
+	if x != nil {
+		return x.Expected
+	}
+	return 0
+}
+
+func (x *FavorDrift) GetMysqlCount() int64 {
+	if x != nil {
+		return x.MysqlCount
+	}
+	return 0
+}
+
+func (x *FavorDrift) GetRedisCount() int64 {
+	if x != nil {
+		return x.RedisCount
+	}
+	return 0
+}
+
+func (x *FavorDrift) GetRedisMissing() bool {
+	if x != nil {
+		return x.RedisMissing
+	}
+	return false
+}
+
+type ReconcileResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind          string        `protobuf:"bytes,1,opt,name=Kind,proto3" json:"Kind,omitempty"`
+	Checked       int64         `protobuf:"varint,2,opt,name=Checked,proto3" json:"Checked,omitempty"`
+	Skipped       int64         `protobuf:"varint,3,opt,name=Skipped,proto3" json:"Skipped,omitempty"` //计数刚变化、消息可能还未写入数据库的id
+	Drifted       int64         `protobuf:"varint,4,opt,name=Drifted,proto3" json:"Drifted,omitempty"`
+	RepairedMysql int64         `protobuf:"varint,5,opt,name=RepairedMysql,proto3" json:"RepairedMysql,omitempty"`
+	RepairedRedis int64         `protobuf:"varint,6,opt,name=RepairedRedis,proto3" json:"RepairedRedis,omitempty"`
+	Drifts        []*FavorDrift `protobuf:"bytes,7,rep,name=Drifts,proto3" json:"Drifts,omitempty"`
+	NextCursor    int64         `protobuf:"varint,8,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"` //全量对账的下一页起始id，为0时已对账完
+}
+
+func (x *ReconcileResult) Reset() {
+	*x = ReconcileResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_like_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcileResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileResult) ProtoMessage() {}
+
+func (x *ReconcileResult) ProtoReflect() protoreflect.Message {
+	mi := &file_like_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileResult.ProtoReflect.Descriptor instead.
+func (*ReconcileResult) Descriptor() ([]byte, []int) {
+	return file_like_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReconcileResult) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReconcileResult) GetChecked() int64 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *ReconcileResult) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ReconcileResult) GetDrifted() int64 {
+	if x != nil {
+		return x.Drifted
+	}
+	return 0
+}
+
+func (x *ReconcileResult) GetRepairedMysql() int64 {
+	if x != nil {
+		return x.RepairedMysql
+	}
+	return 0
+}
+
+func (x *ReconcileResult) GetRepairedRedis() int64 {
+	if x != nil {
+		return x.RepairedRedis
+	}
+	return 0
+}
+
+func (x *ReconcileResult) GetDrifts() []*FavorDrift {
+	if x != nil {
+		return x.Drifts
+	}
+	return nil
+}
+
+func (x *ReconcileResult) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+type ReconcileFavorCountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*ReconcileResult `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
+}
+
+func (x *ReconcileFavorCountResponse) Reset() {
+	*x = ReconcileFavorCountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_like_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcileFavorCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileFavorCountResponse) ProtoMessage() {}
+
+func (x *ReconcileFavorCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_like_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileFavorCountResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileFavorCountResponse) Descriptor() ([]byte, []int) {
+	return file_like_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReconcileFavorCountResponse) GetResults() []*ReconcileResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 var File_like_proto protoreflect.FileDescriptor
 
 var file_like_proto_rawDesc = []byte{
 	0x0a, 0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x73, 0x74,
-	0x61, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x2f, 0x70, 0x6f,
-	0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x97, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x6b,
+	0x61, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x66, 0x65,
+	0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x97, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x6b,
 	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
 	0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
 	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
@@ -659,8 +975,8 @@ var file_like_proto_rawDesc = []byte{
 	0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
 	0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x36, 0x0a, 0x10, 0x4c, 0x69, 0x6b, 0x65,
 	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05,
-	0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f,
-	0x73, 0x74, 0x50, 0x62, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73,
+	0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x66, 0x65,
+	0x65, 0x64, 0x50, 0x62, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73,
 	0x22, 0x51, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x53, 0x6f, 0x75, 0x72, 0x63,
@@ -684,33 +1000,80 @@ var file_like_proto_rawDesc = []byte{
 	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
 	0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0xef, 0x02, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4c, 0x69, 0x6b, 0x65,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69,
-	0x6b, 0x65, 0x12, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x4c, 0x69, 0x6b, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x10, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c,
-	0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69,
-	0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
-	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x49, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x12,
-	0x0e, 0x2e, 0x49, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x0f, 0x2e, 0x49, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x47, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
-	0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
-	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x1f, 0x5a, 0x1d, 0x73, 0x74, 0x61,
-	0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6b, 0x65, 0x2f, 0x6c, 0x69, 0x6b,
-	0x65, 0x50, 0x62, 0x3b, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa8, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x75, 0x6c, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65,
+	0x70, 0x61, 0x69, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x22, 0xa8, 0x01, 0x0a, 0x0a, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x44, 0x72, 0x69, 0x66,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x79, 0x73,
+	0x71, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x4d,
+	0x79, 0x73, 0x71, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x64,
+	0x69, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x52,
+	0x65, 0x64, 0x69, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x64,
+	0x69, 0x73, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x52, 0x65, 0x64, 0x69, 0x73, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x22, 0x84, 0x02,
+	0x0a, 0x0f, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x72, 0x69,
+	0x66, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x44, 0x72, 0x69, 0x66,
+	0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x4d,
+	0x79, 0x73, 0x71, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x52, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x65, 0x64, 0x4d, 0x79, 0x73, 0x71, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x70,
+	0x61, 0x69, 0x72, 0x65, 0x64, 0x52, 0x65, 0x64, 0x69, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x52, 0x65, 0x64, 0x69, 0x73, 0x12,
+	0x23, 0x0a, 0x06, 0x44, 0x72, 0x69, 0x66, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x06, 0x44, 0x72,
+	0x69, 0x66, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x22, 0x49, 0x0a, 0x1b, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x32,
+	0xc1, 0x03, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x35, 0x0a, 0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e,
+	0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x18, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2f, 0x0a, 0x08, 0x4c, 0x69, 0x6b, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x10, 0x2e, 0x4c, 0x69,
+	0x6b, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
+	0x4c, 0x69, 0x6b, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
+	0x06, 0x49, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x0e, 0x2e, 0x49, 0x73, 0x4c, 0x69, 0x6b, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x49, 0x73, 0x4c, 0x69, 0x6b, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x50, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x46, 0x61,
+	0x76, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x1f, 0x5a, 0x1d, 0x73, 0x74, 0x61, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x6c, 0x69, 0x6b, 0x65, 0x2f, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x62, 0x3b, 0x6c, 0x69,
+	0x6b, 0x65, 0x50, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -725,41 +1088,49 @@ func file_like_proto_rawDescGZIP() []byte {
 	return file_like_proto_rawDescData
 }
 
-var file_like_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_like_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_like_proto_goTypes = []interface{}{
-	(*LikeActionRequest)(nil),        // 0: LikeActionRequest
-	(*LikeActionResponse)(nil),       // 1: LikeActionResponse
-	(*GetUserTotalLikeRequest)(nil),  // 2: GetUserTotalLikeRequest
-	(*GetUserTotalLikeResponse)(nil), // 3: GetUserTotalLikeResponse
-	(*LikeListRequest)(nil),          // 4: LikeListRequest
-	(*LikeListResponse)(nil),         // 5: LikeListResponse
-	(*GetLikeCountRequest)(nil),      // 6: GetLikeCountRequest
-	(*GetLikeCountResponse)(nil),     // 7: GetLikeCountResponse
-	(*IsLikeRequest)(nil),            // 8: IsLikeRequest
-	(*IsLikeResponse)(nil),           // 9: IsLikeResponse
-	(*GetUserLikeCountRequest)(nil),  // 10: GetUserLikeCountRequest
-	(*GetUserLikeCountResponse)(nil), // 11: GetUserLikeCountResponse
-	(*postPb.Post)(nil),              // 12: feedPb.Post
+	(*LikeActionRequest)(nil),           // 0: LikeActionRequest
+	(*LikeActionResponse)(nil),          // 1: LikeActionResponse
+	(*GetUserTotalLikeRequest)(nil),     // 2: GetUserTotalLikeRequest
+	(*GetUserTotalLikeResponse)(nil),    // 3: GetUserTotalLikeResponse
+	(*LikeListRequest)(nil),             // 4: LikeListRequest
+	(*LikeListResponse)(nil),            // 5: LikeListResponse
+	(*GetLikeCountRequest)(nil),         // 6: GetLikeCountRequest
+	(*GetLikeCountResponse)(nil),        // 7: GetLikeCountResponse
+	(*IsLikeRequest)(nil),               // 8: IsLikeRequest
+	(*IsLikeResponse)(nil),              // 9: IsLikeResponse
+	(*GetUserLikeCountRequest)(nil),     // 10: GetUserLikeCountRequest
+	(*GetUserLikeCountResponse)(nil),    // 11: GetUserLikeCountResponse
+	(*ReconcileFavorCountRequest)(nil),  // 12: ReconcileFavorCountRequest
+	(*FavorDrift)(nil),                  // 13: FavorDrift
+	(*ReconcileResult)(nil),             // 14: ReconcileResult
+	(*ReconcileFavorCountResponse)(nil), // 15: ReconcileFavorCountResponse
+	(*feedPb.Post)(nil),                 // 16: feedPb.Post
 }
 var file_like_proto_depIdxs = []int32{
-	12, // 0: LikeListResponse.posts:type_name -> feedPb.Post
-	0,  // 1: LikeService.LikeAction:input_type -> LikeActionRequest
-	2,  // 2: LikeService.GetUserTotalLike:input_type -> GetUserTotalLikeRequest
-	4,  // 3: LikeService.LikeList:input_type -> LikeListRequest
-	6,  // 4: LikeService.GetLikeCount:input_type -> GetLikeCountRequest
-	8,  // 5: LikeService.IsLike:input_type -> IsLikeRequest
-	10, // 6: LikeService.GetUserLikeCount:input_type -> GetUserLikeCountRequest
-	1,  // 7: LikeService.LikeAction:output_type -> LikeActionResponse
-	3,  // 8: LikeService.GetUserTotalLike:output_type -> GetUserTotalLikeResponse
-	5,  // 9: LikeService.LikeList:output_type -> LikeListResponse
-	7,  // 10: LikeService.GetLikeCount:output_type -> GetLikeCountResponse
-	9,  // 11: LikeService.IsLike:output_type -> IsLikeResponse
-	11, // 12: LikeService.GetUserLikeCount:output_type -> GetUserLikeCountResponse
-	7,  // [7:13] is the sub-list for method output_type
-	1,  // [1:7] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	16, // 0: LikeListResponse.posts:type_name -> feedPb.Post
+	13, // 1: ReconcileResult.Drifts:type_name -> FavorDrift
+	14, // 2: ReconcileFavorCountResponse.Results:type_name -> ReconcileResult
+	0,  // 3: LikeService.LikeAction:input_type -> LikeActionRequest
+	2,  // 4: LikeService.GetUserTotalLike:input_type -> GetUserTotalLikeRequest
+	4,  // 5: LikeService.LikeList:input_type -> LikeListRequest
+	6,  // 6: LikeService.GetLikeCount:input_type -> GetLikeCountRequest
+	8,  // 7: LikeService.IsLike:input_type -> IsLikeRequest
+	10, // 8: LikeService.GetUserLikeCount:input_type -> GetUserLikeCountRequest
+	12, // 9: LikeService.ReconcileFavorCount:input_type -> ReconcileFavorCountRequest
+	1,  // 10: LikeService.LikeAction:output_type -> LikeActionResponse
+	3,  // 11: LikeService.GetUserTotalLike:output_type -> GetUserTotalLikeResponse
+	5,  // 12: LikeService.LikeList:output_type -> LikeListResponse
+	7,  // 13: LikeService.GetLikeCount:output_type -> GetLikeCountResponse
+	9,  // 14: LikeService.IsLike:output_type -> IsLikeResponse
+	11, // 15: LikeService.GetUserLikeCount:output_type -> GetUserLikeCountResponse
+	15, // 16: LikeService.ReconcileFavorCount:output_type -> ReconcileFavorCountResponse
+	10, // [10:17] is the sub-list for method output_type
+	3,  // [3:10] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_like_proto_init() }
@@ -912,6 +1283,54 @@ func file_like_proto_init() {
 				return nil
 			}
 		}
+		file_like_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcileFavorCountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_like_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FavorDrift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_like_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcileResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_like_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcileFavorCountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -919,7 +1338,7 @@ func file_like_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_like_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -43,6 +43,7 @@ type LikeService interface {
 	GetLikeCount(ctx context.Context, in *GetLikeCountRequest, opts ...client.CallOption) (*GetLikeCountResponse, error)
 	IsLike(ctx context.Context, in *IsLikeRequest, opts ...client.CallOption) (*IsLikeResponse, error)
 	GetUserLikeCount(ctx context.Context, in *GetUserLikeCountRequest, opts ...client.CallOption) (*GetUserLikeCountResponse, error)
+	ReconcileFavorCount(ctx context.Context, in *ReconcileFavorCountRequest, opts ...client.CallOption) (*ReconcileFavorCountResponse, error)
 }
 
 type likeService struct {
@@ -117,6 +118,16 @@ func (c *likeService) GetUserLikeCount(ctx context.Context, in *GetUserLikeCount
 	return out, nil
 }
 
+func (c *likeService) ReconcileFavorCount(ctx context.Context, in *ReconcileFavorCountRequest, opts ...client.CallOption) (*ReconcileFavorCountResponse, error) {
+	req := c.c.NewRequest(c.name, "LikeService.ReconcileFavorCount", in)
+	out := new(ReconcileFavorCountResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for LikeService service
 
 type LikeServiceHandler interface {
@@ -126,6 +137,7 @@ type LikeServiceHandler interface {
 	GetLikeCount(context.Context, *GetLikeCountRequest, *GetLikeCountResponse) error
 	IsLike(context.Context, *IsLikeRequest, *IsLikeResponse) error
 	GetUserLikeCount(context.Context, *GetUserLikeCountRequest, *GetUserLikeCountResponse) error
+	ReconcileFavorCount(context.Context, *ReconcileFavorCountRequest, *ReconcileFavorCountResponse) error
 }
 
 func RegisterLikeServiceHandler(s server.Server, hdlr LikeServiceHandler, opts ...server.HandlerOption) error {
@@ -136,6 +148,7 @@ func RegisterLikeServiceHandler(s server.Server, hdlr LikeServiceHandler, opts .
 		GetLikeCount(ctx context.Context, in *GetLikeCountRequest, out *GetLikeCountResponse) error
 		IsLike(ctx context.Context, in *IsLikeRequest, out *IsLikeResponse) error
 		GetUserLikeCount(ctx context.Context, in *GetUserLikeCountRequest, out *GetUserLikeCountResponse) error
+		ReconcileFavorCount(ctx context.Context, in *ReconcileFavorCountRequest, out *ReconcileFavorCountResponse) error
 	}
 	type LikeService struct {
 		likeService
@@ -171,3 +184,7 @@ func (h *likeServiceHandler) IsLike(ctx context.Context, in *IsLikeRequest, out
 func (h *likeServiceHandler) GetUserLikeCount(ctx context.Context, in *GetUserLikeCountRequest, out *GetUserLikeCountResponse) error {
 	return h.LikeServiceHandler.GetUserLikeCount(ctx, in, out)
 }
+
+func (h *likeServiceHandler) ReconcileFavorCount(ctx context.Context, in *ReconcileFavorCountRequest, out *ReconcileFavorCountResponse) error {
+	return h.LikeServiceHandler.ReconcileFavorCount(ctx, in, out)
+}
@@ -43,6 +43,16 @@ type MessageService interface {
 	GetChatList(ctx context.Context, in *GetChatListRequest, opts ...client.CallOption) (*GetChatListResponse, error)
 	LoadMessage(ctx context.Context, in *LoadMessageRequest, opts ...client.CallOption) (*LoadMessageResponse, error)
 	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...client.CallOption) (*SendMessageResponse, error)
+	SendGroupMessage(ctx context.Context, in *SendGroupMessageRequest, opts ...client.CallOption) (*SendGroupMessageResponse, error)
+	LoadGroupMessage(ctx context.Context, in *LoadGroupMessageRequest, opts ...client.CallOption) (*LoadGroupMessageResponse, error)
+	GetGroupUnreadCount(ctx context.Context, in *GetGroupUnreadCountRequest, opts ...client.CallOption) (*GetGroupUnreadCountResponse, error)
+	MarkGroupRead(ctx context.Context, in *MarkGroupReadRequest, opts ...client.CallOption) (*MarkGroupReadResponse, error)
+	MarkChatRead(ctx context.Context, in *MarkChatReadRequest, opts ...client.CallOption) (*MarkChatReadResponse, error)
+	MarkRemindRead(ctx context.Context, in *MarkRemindReadRequest, opts ...client.CallOption) (*MarkRemindReadResponse, error)
+	MarkAllRead(ctx context.Context, in *MarkAllReadRequest, opts ...client.CallOption) (*MarkAllReadResponse, error)
+	ListRemindMessages(ctx context.Context, in *ListRemindMessagesRequest, opts ...client.CallOption) (*ListRemindMessagesResponse, error)
+	ListSystemMessages(ctx context.Context, in *ListSystemMessagesRequest, opts ...client.CallOption) (*ListSystemMessagesResponse, error)
+	RevokeSystemMessage(ctx context.Context, in *RevokeSystemMessageRequest, opts ...client.CallOption) (*RevokeSystemMessageResponse, error)
 }
 
 type messageService struct {
@@ -127,6 +137,106 @@ func (c *messageService) SendMessage(ctx context.Context, in *SendMessageRequest
 	return out, nil
 }
 
+func (c *messageService) SendGroupMessage(ctx context.Context, in *SendGroupMessageRequest, opts ...client.CallOption) (*SendGroupMessageResponse, error) {
+	req := c.c.NewRequest(c.name, "MessageService.SendGroupMessage", in)
+	out := new(SendGroupMessageResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageService) LoadGroupMessage(ctx context.Context, in *LoadGroupMessageRequest, opts ...client.CallOption) (*LoadGroupMessageResponse, error) {
+	req := c.c.NewRequest(c.name, "MessageService.LoadGroupMessage", in)
+	out := new(LoadGroupMessageResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageService) GetGroupUnreadCount(ctx context.Context, in *GetGroupUnreadCountRequest, opts ...client.CallOption) (*GetGroupUnreadCountResponse, error) {
+	req := c.c.NewRequest(c.name, "MessageService.GetGroupUnreadCount", in)
+	out := new(GetGroupUnreadCountResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageService) MarkGroupRead(ctx context.Context, in *MarkGroupReadRequest, opts ...client.CallOption) (*MarkGroupReadResponse, error) {
+	req := c.c.NewRequest(c.name, "MessageService.MarkGroupRead", in)
+	out := new(MarkGroupReadResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageService) MarkChatRead(ctx context.Context, in *MarkChatReadRequest, opts ...client.CallOption) (*MarkChatReadResponse, error) {
+	req := c.c.NewRequest(c.name, "MessageService.MarkChatRead", in)
+	out := new(MarkChatReadResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageService) MarkRemindRead(ctx context.Context, in *MarkRemindReadRequest, opts ...client.CallOption) (*MarkRemindReadResponse, error) {
+	req := c.c.NewRequest(c.name, "MessageService.MarkRemindRead", in)
+	out := new(MarkRemindReadResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageService) MarkAllRead(ctx context.Context, in *MarkAllReadRequest, opts ...client.CallOption) (*MarkAllReadResponse, error) {
+	req := c.c.NewRequest(c.name, "MessageService.MarkAllRead", in)
+	out := new(MarkAllReadResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageService) ListRemindMessages(ctx context.Context, in *ListRemindMessagesRequest, opts ...client.CallOption) (*ListRemindMessagesResponse, error) {
+	req := c.c.NewRequest(c.name, "MessageService.ListRemindMessages", in)
+	out := new(ListRemindMessagesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageService) ListSystemMessages(ctx context.Context, in *ListSystemMessagesRequest, opts ...client.CallOption) (*ListSystemMessagesResponse, error) {
+	req := c.c.NewRequest(c.name, "MessageService.ListSystemMessages", in)
+	out := new(ListSystemMessagesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageService) RevokeSystemMessage(ctx context.Context, in *RevokeSystemMessageRequest, opts ...client.CallOption) (*RevokeSystemMessageResponse, error) {
+	req := c.c.NewRequest(c.name, "MessageService.RevokeSystemMessage", in)
+	out := new(RevokeSystemMessageResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for MessageService service
 
 type MessageServiceHandler interface {
@@ -137,6 +247,16 @@ type MessageServiceHandler interface {
 	GetChatList(context.Context, *GetChatListRequest, *GetChatListResponse) error
 	LoadMessage(context.Context, *LoadMessageRequest, *LoadMessageResponse) error
 	SendMessage(context.Context, *SendMessageRequest, *SendMessageResponse) error
+	SendGroupMessage(context.Context, *SendGroupMessageRequest, *SendGroupMessageResponse) error
+	LoadGroupMessage(context.Context, *LoadGroupMessageRequest, *LoadGroupMessageResponse) error
+	GetGroupUnreadCount(context.Context, *GetGroupUnreadCountRequest, *GetGroupUnreadCountResponse) error
+	MarkGroupRead(context.Context, *MarkGroupReadRequest, *MarkGroupReadResponse) error
+	MarkChatRead(context.Context, *MarkChatReadRequest, *MarkChatReadResponse) error
+	MarkRemindRead(context.Context, *MarkRemindReadRequest, *MarkRemindReadResponse) error
+	MarkAllRead(context.Context, *MarkAllReadRequest, *MarkAllReadResponse) error
+	ListRemindMessages(context.Context, *ListRemindMessagesRequest, *ListRemindMessagesResponse) error
+	ListSystemMessages(context.Context, *ListSystemMessagesRequest, *ListSystemMessagesResponse) error
+	RevokeSystemMessage(context.Context, *RevokeSystemMessageRequest, *RevokeSystemMessageResponse) error
 }
 
 func RegisterMessageServiceHandler(s server.Server, hdlr MessageServiceHandler, opts ...server.HandlerOption) error {
@@ -148,6 +268,16 @@ func RegisterMessageServiceHandler(s server.Server, hdlr MessageServiceHandler,
 		GetChatList(ctx context.Context, in *GetChatListRequest, out *GetChatListResponse) error
 		LoadMessage(ctx context.Context, in *LoadMessageRequest, out *LoadMessageResponse) error
 		SendMessage(ctx context.Context, in *SendMessageRequest, out *SendMessageResponse) error
+		SendGroupMessage(ctx context.Context, in *SendGroupMessageRequest, out *SendGroupMessageResponse) error
+		LoadGroupMessage(ctx context.Context, in *LoadGroupMessageRequest, out *LoadGroupMessageResponse) error
+		GetGroupUnreadCount(ctx context.Context, in *GetGroupUnreadCountRequest, out *GetGroupUnreadCountResponse) error
+		MarkGroupRead(ctx context.Context, in *MarkGroupReadRequest, out *MarkGroupReadResponse) error
+		MarkChatRead(ctx context.Context, in *MarkChatReadRequest, out *MarkChatReadResponse) error
+		MarkRemindRead(ctx context.Context, in *MarkRemindReadRequest, out *MarkRemindReadResponse) error
+		MarkAllRead(ctx context.Context, in *MarkAllReadRequest, out *MarkAllReadResponse) error
+		ListRemindMessages(ctx context.Context, in *ListRemindMessagesRequest, out *ListRemindMessagesResponse) error
+		ListSystemMessages(ctx context.Context, in *ListSystemMessagesRequest, out *ListSystemMessagesResponse) error
+		RevokeSystemMessage(ctx context.Context, in *RevokeSystemMessageRequest, out *RevokeSystemMessageResponse) error
 	}
 	type MessageService struct {
 		messageService
@@ -187,3 +317,43 @@ func (h *messageServiceHandler) LoadMessage(ctx context.Context, in *LoadMessage
 func (h *messageServiceHandler) SendMessage(ctx context.Context, in *SendMessageRequest, out *SendMessageResponse) error {
 	return h.MessageServiceHandler.SendMessage(ctx, in, out)
 }
+
+func (h *messageServiceHandler) SendGroupMessage(ctx context.Context, in *SendGroupMessageRequest, out *SendGroupMessageResponse) error {
+	return h.MessageServiceHandler.SendGroupMessage(ctx, in, out)
+}
+
+func (h *messageServiceHandler) LoadGroupMessage(ctx context.Context, in *LoadGroupMessageRequest, out *LoadGroupMessageResponse) error {
+	return h.MessageServiceHandler.LoadGroupMessage(ctx, in, out)
+}
+
+func (h *messageServiceHandler) GetGroupUnreadCount(ctx context.Context, in *GetGroupUnreadCountRequest, out *GetGroupUnreadCountResponse) error {
+	return h.MessageServiceHandler.GetGroupUnreadCount(ctx, in, out)
+}
+
+func (h *messageServiceHandler) MarkGroupRead(ctx context.Context, in *MarkGroupReadRequest, out *MarkGroupReadResponse) error {
+	return h.MessageServiceHandler.MarkGroupRead(ctx, in, out)
+}
+
+func (h *messageServiceHandler) MarkChatRead(ctx context.Context, in *MarkChatReadRequest, out *MarkChatReadResponse) error {
+	return h.MessageServiceHandler.MarkChatRead(ctx, in, out)
+}
+
+func (h *messageServiceHandler) MarkRemindRead(ctx context.Context, in *MarkRemindReadRequest, out *MarkRemindReadResponse) error {
+	return h.MessageServiceHandler.MarkRemindRead(ctx, in, out)
+}
+
+func (h *messageServiceHandler) MarkAllRead(ctx context.Context, in *MarkAllReadRequest, out *MarkAllReadResponse) error {
+	return h.MessageServiceHandler.MarkAllRead(ctx, in, out)
+}
+
+func (h *messageServiceHandler) ListRemindMessages(ctx context.Context, in *ListRemindMessagesRequest, out *ListRemindMessagesResponse) error {
+	return h.MessageServiceHandler.ListRemindMessages(ctx, in, out)
+}
+
+func (h *messageServiceHandler) ListSystemMessages(ctx context.Context, in *ListSystemMessagesRequest, out *ListSystemMessagesResponse) error {
+	return h.MessageServiceHandler.ListSystemMessages(ctx, in, out)
+}
+
+func (h *messageServiceHandler) RevokeSystemMessage(ctx context.Context, in *RevokeSystemMessageRequest, out *RevokeSystemMessageResponse) error {
+	return h.MessageServiceHandler.RevokeSystemMessage(ctx, in, out)
+}
@@ -38,9 +38,13 @@ func NewPublishServiceEndpoints() []*api.Endpoint {
 
 type PublishService interface {
 	PreUploadVideos(ctx context.Context, in *PreUploadVideosRequest, opts ...client.CallOption) (*PreUploadVideosResponse, error)
+	UploadVideoChunk(ctx context.Context, in *UploadVideoChunkRequest, opts ...client.CallOption) (*UploadVideoChunkResponse, error)
+	CompleteVideoUpload(ctx context.Context, in *CompleteVideoUploadRequest, opts ...client.CallOption) (*CompleteVideoUploadResponse, error)
 	CreatePost(ctx context.Context, in *CreatePostRequest, opts ...client.CallOption) (*CreatePostResponse, error)
 	CountPost(ctx context.Context, in *CountPostRequest, opts ...client.CallOption) (*CountPostResponse, error)
 	ListPost(ctx context.Context, in *ListPostRequest, opts ...client.CallOption) (*ListPostResponse, error)
+	UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...client.CallOption) (*UpdatePostResponse, error)
+	DeletePost(ctx context.Context, in *DeletePostRequest, opts ...client.CallOption) (*DeletePostResponse, error)
 }
 
 type publishService struct {
@@ -65,6 +69,26 @@ func (c *publishService) PreUploadVideos(ctx context.Context, in *PreUploadVideo
 	return out, nil
 }
 
+func (c *publishService) UploadVideoChunk(ctx context.Context, in *UploadVideoChunkRequest, opts ...client.CallOption) (*UploadVideoChunkResponse, error) {
+	req := c.c.NewRequest(c.name, "PublishService.UploadVideoChunk", in)
+	out := new(UploadVideoChunkResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publishService) CompleteVideoUpload(ctx context.Context, in *CompleteVideoUploadRequest, opts ...client.CallOption) (*CompleteVideoUploadResponse, error) {
+	req := c.c.NewRequest(c.name, "PublishService.CompleteVideoUpload", in)
+	out := new(CompleteVideoUploadResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *publishService) CreatePost(ctx context.Context, in *CreatePostRequest, opts ...client.CallOption) (*CreatePostResponse, error) {
 	req := c.c.NewRequest(c.name, "PublishService.CreatePost", in)
 	out := new(CreatePostResponse)
@@ -95,21 +119,49 @@ func (c *publishService) ListPost(ctx context.Context, in *ListPostRequest, opts
 	return out, nil
 }
 
+func (c *publishService) UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...client.CallOption) (*UpdatePostResponse, error) {
+	req := c.c.NewRequest(c.name, "PublishService.UpdatePost", in)
+	out := new(UpdatePostResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publishService) DeletePost(ctx context.Context, in *DeletePostRequest, opts ...client.CallOption) (*DeletePostResponse, error) {
+	req := c.c.NewRequest(c.name, "PublishService.DeletePost", in)
+	out := new(DeletePostResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for PublishService service
 
 type PublishServiceHandler interface {
 	PreUploadVideos(context.Context, *PreUploadVideosRequest, *PreUploadVideosResponse) error
+	UploadVideoChunk(context.Context, *UploadVideoChunkRequest, *UploadVideoChunkResponse) error
+	CompleteVideoUpload(context.Context, *CompleteVideoUploadRequest, *CompleteVideoUploadResponse) error
 	CreatePost(context.Context, *CreatePostRequest, *CreatePostResponse) error
 	CountPost(context.Context, *CountPostRequest, *CountPostResponse) error
 	ListPost(context.Context, *ListPostRequest, *ListPostResponse) error
+	UpdatePost(context.Context, *UpdatePostRequest, *UpdatePostResponse) error
+	DeletePost(context.Context, *DeletePostRequest, *DeletePostResponse) error
 }
 
 func RegisterPublishServiceHandler(s server.Server, hdlr PublishServiceHandler, opts ...server.HandlerOption) error {
 	type publishService interface {
 		PreUploadVideos(ctx context.Context, in *PreUploadVideosRequest, out *PreUploadVideosResponse) error
+		UploadVideoChunk(ctx context.Context, in *UploadVideoChunkRequest, out *UploadVideoChunkResponse) error
+		CompleteVideoUpload(ctx context.Context, in *CompleteVideoUploadRequest, out *CompleteVideoUploadResponse) error
 		CreatePost(ctx context.Context, in *CreatePostRequest, out *CreatePostResponse) error
 		CountPost(ctx context.Context, in *CountPostRequest, out *CountPostResponse) error
 		ListPost(ctx context.Context, in *ListPostRequest, out *ListPostResponse) error
+		UpdatePost(ctx context.Context, in *UpdatePostRequest, out *UpdatePostResponse) error
+		DeletePost(ctx context.Context, in *DeletePostRequest, out *DeletePostResponse) error
 	}
 	type PublishService struct {
 		publishService
@@ -126,6 +178,14 @@ func (h *publishServiceHandler) PreUploadVideos(ctx context.Context, in *PreUplo
 	return h.PublishServiceHandler.PreUploadVideos(ctx, in, out)
 }
 
+func (h *publishServiceHandler) UploadVideoChunk(ctx context.Context, in *UploadVideoChunkRequest, out *UploadVideoChunkResponse) error {
+	return h.PublishServiceHandler.UploadVideoChunk(ctx, in, out)
+}
+
+func (h *publishServiceHandler) CompleteVideoUpload(ctx context.Context, in *CompleteVideoUploadRequest, out *CompleteVideoUploadResponse) error {
+	return h.PublishServiceHandler.CompleteVideoUpload(ctx, in, out)
+}
+
 func (h *publishServiceHandler) CreatePost(ctx context.Context, in *CreatePostRequest, out *CreatePostResponse) error {
 	return h.PublishServiceHandler.CreatePost(ctx, in, out)
 }
@@ -137,3 +197,11 @@ func (h *publishServiceHandler) CountPost(ctx context.Context, in *CountPostRequ
 func (h *publishServiceHandler) ListPost(ctx context.Context, in *ListPostRequest, out *ListPostResponse) error {
 	return h.PublishServiceHandler.ListPost(ctx, in, out)
 }
+
+func (h *publishServiceHandler) UpdatePost(ctx context.Context, in *UpdatePostRequest, out *UpdatePostResponse) error {
+	return h.PublishServiceHandler.UpdatePost(ctx, in, out)
+}
+
+func (h *publishServiceHandler) DeletePost(ctx context.Context, in *DeletePostRequest, out *DeletePostResponse) error {
+	return h.PublishServiceHandler.DeletePost(ctx, in, out)
+}
@@ -41,6 +41,14 @@ type UserService interface {
 	Signup(ctx context.Context, in *LSRequest, opts ...client.CallOption) (*EmptyLSResponse, error)
 	GetUserInfo(ctx context.Context, in *GetUserInfoRequest, opts ...client.CallOption) (*GetUserInfoResponse, error)
 	GetUserExistInformation(ctx context.Context, in *GetUserExistInformationRequest, opts ...client.CallOption) (*GetUserExistInformationResponse, error)
+	//根据用户名批量查询用户id，不存在的用户名不返回
+	GetUserIdsByUsername(ctx context.Context, in *GetUserIdsByUsernameRequest, opts ...client.CallOption) (*GetUserIdsByUsernameResponse, error)
+	//使用refreshToken换取新的token，旧的refreshToken随即失效，再次使用会撤销整个登录会话
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...client.CallOption) (*RefreshTokenResponse, error)
+	//退出当前或指定设备的登录
+	Logout(ctx context.Context, in *LogoutRequest, opts ...client.CallOption) (*LogoutResponse, error)
+	LogoutAllDevices(ctx context.Context, in *LogoutAllDevicesRequest, opts ...client.CallOption) (*LogoutResponse, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...client.CallOption) (*ListSessionsResponse, error)
 }
 
 type userService struct {
@@ -105,6 +113,56 @@ func (c *userService) GetUserExistInformation(ctx context.Context, in *GetUserEx
 	return out, nil
 }
 
+func (c *userService) GetUserIdsByUsername(ctx context.Context, in *GetUserIdsByUsernameRequest, opts ...client.CallOption) (*GetUserIdsByUsernameResponse, error) {
+	req := c.c.NewRequest(c.name, "UserService.GetUserIdsByUsername", in)
+	out := new(GetUserIdsByUsernameResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userService) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...client.CallOption) (*RefreshTokenResponse, error) {
+	req := c.c.NewRequest(c.name, "UserService.RefreshToken", in)
+	out := new(RefreshTokenResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userService) Logout(ctx context.Context, in *LogoutRequest, opts ...client.CallOption) (*LogoutResponse, error) {
+	req := c.c.NewRequest(c.name, "UserService.Logout", in)
+	out := new(LogoutResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userService) LogoutAllDevices(ctx context.Context, in *LogoutAllDevicesRequest, opts ...client.CallOption) (*LogoutResponse, error) {
+	req := c.c.NewRequest(c.name, "UserService.LogoutAllDevices", in)
+	out := new(LogoutResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userService) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...client.CallOption) (*ListSessionsResponse, error) {
+	req := c.c.NewRequest(c.name, "UserService.ListSessions", in)
+	out := new(ListSessionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for UserService service
 
 type UserServiceHandler interface {
@@ -113,6 +171,14 @@ type UserServiceHandler interface {
 	Signup(context.Context, *LSRequest, *EmptyLSResponse) error
 	GetUserInfo(context.Context, *GetUserInfoRequest, *GetUserInfoResponse) error
 	GetUserExistInformation(context.Context, *GetUserExistInformationRequest, *GetUserExistInformationResponse) error
+	//根据用户名批量查询用户id，不存在的用户名不返回
+	GetUserIdsByUsername(context.Context, *GetUserIdsByUsernameRequest, *GetUserIdsByUsernameResponse) error
+	//使用refreshToken换取新的token，旧的refreshToken随即失效，再次使用会撤销整个登录会话
+	RefreshToken(context.Context, *RefreshTokenRequest, *RefreshTokenResponse) error
+	//退出当前或指定设备的登录
+	Logout(context.Context, *LogoutRequest, *LogoutResponse) error
+	LogoutAllDevices(context.Context, *LogoutAllDevicesRequest, *LogoutResponse) error
+	ListSessions(context.Context, *ListSessionsRequest, *ListSessionsResponse) error
 }
 
 func RegisterUserServiceHandler(s server.Server, hdlr UserServiceHandler, opts ...server.HandlerOption) error {
@@ -122,6 +188,11 @@ func RegisterUserServiceHandler(s server.Server, hdlr UserServiceHandler, opts .
 		Signup(ctx context.Context, in *LSRequest, out *EmptyLSResponse) error
 		GetUserInfo(ctx context.Context, in *GetUserInfoRequest, out *GetUserInfoResponse) error
 		GetUserExistInformation(ctx context.Context, in *GetUserExistInformationRequest, out *GetUserExistInformationResponse) error
+		GetUserIdsByUsername(ctx context.Context, in *GetUserIdsByUsernameRequest, out *GetUserIdsByUsernameResponse) error
+		RefreshToken(ctx context.Context, in *RefreshTokenRequest, out *RefreshTokenResponse) error
+		Logout(ctx context.Context, in *LogoutRequest, out *LogoutResponse) error
+		LogoutAllDevices(ctx context.Context, in *LogoutAllDevicesRequest, out *LogoutResponse) error
+		ListSessions(ctx context.Context, in *ListSessionsRequest, out *ListSessionsResponse) error
 	}
 	type UserService struct {
 		userService
@@ -153,3 +224,23 @@ func (h *userServiceHandler) GetUserInfo(ctx context.Context, in *GetUserInfoReq
 func (h *userServiceHandler) GetUserExistInformation(ctx context.Context, in *GetUserExistInformationRequest, out *GetUserExistInformationResponse) error {
 	return h.UserServiceHandler.GetUserExistInformation(ctx, in, out)
 }
+
+func (h *userServiceHandler) GetUserIdsByUsername(ctx context.Context, in *GetUserIdsByUsernameRequest, out *GetUserIdsByUsernameResponse) error {
+	return h.UserServiceHandler.GetUserIdsByUsername(ctx, in, out)
+}
+
+func (h *userServiceHandler) RefreshToken(ctx context.Context, in *RefreshTokenRequest, out *RefreshTokenResponse) error {
+	return h.UserServiceHandler.RefreshToken(ctx, in, out)
+}
+
+func (h *userServiceHandler) Logout(ctx context.Context, in *LogoutRequest, out *LogoutResponse) error {
+	return h.UserServiceHandler.Logout(ctx, in, out)
+}
+
+func (h *userServiceHandler) LogoutAllDevices(ctx context.Context, in *LogoutAllDevicesRequest, out *LogoutResponse) error {
+	return h.UserServiceHandler.LogoutAllDevices(ctx, in, out)
+}
+
+func (h *userServiceHandler) ListSessions(ctx context.Context, in *ListSessionsRequest, out *ListSessionsResponse) error {
+	return h.UserServiceHandler.ListSessions(ctx, in, out)
+}
@@ -1,12 +1,143 @@
 package test
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"go.uber.org/zap"
+	"image"
+	"image/jpeg"
+	"mime/multipart"
+	"net/url"
+	"os"
+	"star/app/constant/str"
 	"star/app/storage/file"
+	"strings"
 	"testing"
+	"time"
 )
 
-func TestUploadToQiNiu(t *testing.T) {
-	key := "img/" + f
-	file.UploadToQiNiu(context.Background())
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	storage, err := file.NewLocalStorage(dir, "http://localhost:8080/file/local", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := "img/2024-01/test.txt"
+	content := "hello star"
+	fileUrl, err := storage.Put(ctx, key, strings.NewReader(content), int64(len(content)), "text/plain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fileUrl != "http://localhost:8080/file/local/"+key {
+		t.Fatalf("unexpected url: %s", fileUrl)
+	}
+	info, err := storage.Stat(ctx, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size != int64(len(content)) {
+		t.Fatalf("unexpected size: %d", info.Size)
+	}
+
+	signedUrl, err := storage.SignedURL(ctx, key, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(signedUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !storage.VerifySignedURL(key, u.Query().Get("e"), u.Query().Get("token")) {
+		t.Fatal("signed url should be valid")
+	}
+	if storage.VerifySignedURL("img/other.txt", u.Query().Get("e"), u.Query().Get("token")) {
+		t.Fatal("signed url should not be valid for other key")
+	}
+
+	if p, err := storage.Path("../../etc/passwd"); err != nil || !strings.HasPrefix(p, dir) {
+		t.Fatalf("path escaped storage dir: %s", p)
+	}
+	if err := storage.Delete(ctx, key); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := storage.Stat(ctx, key); !errors.Is(err, file.ErrNotExist) {
+		t.Fatalf("expected ErrNotExist, got %v", err)
+	}
+}
+
+// TestIsPublicKey 只有图片和视频目录可以不带签名访问
+func TestIsPublicKey(t *testing.T) {
+	for key, public := range map[string]bool{
+		"img/2024-01/a.jpg":    true,
+		"/video/2024-01/a.mp4": true,
+		"temp/20240101/a":      false,
+		"img/../temp/a":        false,
+		"imgs/a.jpg":           false,
+	} {
+		if file.IsPublicKey(key) != public {
+			t.Errorf("IsPublicKey(%q)=%v, want %v", key, !public, public)
+		}
+	}
+}
+
+func newImageFileHeader(t *testing.T, filename string, data []byte) *multipart.FileHeader {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	part.Write(data)
+	writer.Close()
+	form, err := multipart.NewReader(body, writer.Boundary()).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return form.File["file"][0]
+}
+
+func TestUploadImage(t *testing.T) {
+	ctx := context.Background()
+	storage, err := file.NewLocalStorage(t.TempDir(), "http://localhost:8080/file/local", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file.Default = storage
+
+	img := image.NewRGBA(image.Rect(0, 0, 300, 100))
+	buf := new(bytes.Buffer)
+	if err := jpeg.Encode(buf, img, nil); err != nil {
+		t.Fatal(err)
+	}
+	//文件名后缀与实际格式不一致时以文件内容为准
+	urls, err := file.UploadImage(ctx, "img/", "_test", file.ImageAvatar, newImageFileHeader(t, "avatar.png", buf.Bytes()), zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, variant := range []string{file.VariantOrigin, "small", "medium"} {
+		if !strings.HasSuffix(urls[variant], ".jpg") {
+			t.Fatalf("unexpected %s url: %s", variant, urls[variant])
+		}
+	}
+	key := strings.TrimPrefix(urls["small"], "http://localhost:8080/file/local/")
+	p, _ := storage.Path(key)
+	f, err := os.Open(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	config, err := jpeg.DecodeConfig(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Width != 64 || config.Height != 64 {
+		t.Fatalf("unexpected thumbnail size: %dx%d", config.Width, config.Height)
+	}
+
+	_, err = file.UploadImage(ctx, "img/", "_test", file.ImagePost, newImageFileHeader(t, "a.jpg", []byte("not an image")), zap.NewNop())
+	if !errors.Is(err, str.ErrInvalidImage) {
+		t.Fatalf("expected ErrInvalidImage, got %v", err)
+	}
 }
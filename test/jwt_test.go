@@ -0,0 +1,162 @@
+package test
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"star/app/constant/settings"
+	"star/app/utils/jwt"
+	"testing"
+)
+
+// newJwtKey 生成PEM格式的私钥配置
+func newJwtKey(t *testing.T, kid string, alg string) settings.JwtKey {
+	var private interface{}
+	switch alg {
+	case jwt.AlgRS256:
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatal(err)
+		}
+		private = key
+	case jwt.AlgEdDSA:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		private = key
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return settings.JwtKey{
+		Kid:        kid,
+		Alg:        alg,
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+	}
+}
+
+// publicJwtKey 只包含公钥的密钥配置
+func publicJwtKey(t *testing.T, key settings.JwtKey) settings.JwtKey {
+	block, _ := pem.Decode([]byte(key.PrivateKey))
+	private, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(private.(crypto.Signer).Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return settings.JwtKey{
+		Kid:       key.Kid,
+		Alg:       key.Alg,
+		PublicKey: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+	}
+}
+
+// useJwtKeys 使用给定的密钥配置，测试结束后恢复
+func useJwtKeys(t *testing.T, signingKid string, keys ...settings.JwtKey) {
+	old := settings.Conf.JwtConfig
+	t.Cleanup(func() {
+		settings.Conf.JwtConfig = old
+		_ = jwt.LoadKeys()
+	})
+	settings.Conf.JwtConfig = &settings.JwtConfig{SigningKid: signingKid, Keys: keys}
+	if err := jwt.LoadKeys(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestSessionToken token带有会话id，accessToken和refreshToken不能混用
+func TestSessionToken(t *testing.T) {
+	useJwtKeys(t, "ed", newJwtKey(t, "ed", jwt.AlgEdDSA))
+	accessToken, refreshToken, err := jwt.GetToken(1, "100", "200")
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims, err := jwt.ParseAccessToken(accessToken)
+	if err != nil {
+		t.Fatalf("parse access token: %v", err)
+	}
+	if claims.UserID != 1 || claims.SessionId != "100" {
+		t.Errorf("access token claims=%+v", claims)
+	}
+	claims, err = jwt.ParseRefreshToken(refreshToken)
+	if err != nil {
+		t.Fatalf("parse refresh token: %v", err)
+	}
+	if claims.UserID != 1 || claims.SessionId != "100" || claims.ID != "200" {
+		t.Errorf("refresh token claims=%+v", claims)
+	}
+	if _, err := jwt.ParseAccessToken(refreshToken); err == nil {
+		t.Error("refresh token accepted as access token")
+	}
+	if _, err := jwt.ParseRefreshToken(accessToken); err == nil {
+		t.Error("access token accepted as refresh token")
+	}
+}
+
+// TestTokenAudience 用户token和管理员token不能互相使用
+func TestTokenAudience(t *testing.T) {
+	useJwtKeys(t, "rsa", newJwtKey(t, "rsa", jwt.AlgRS256))
+	userToken, err := jwt.GetAccessToken(1, "100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	adminToken, err := jwt.GetAdminToken(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := jwt.ParseAdminToken(adminToken); err != nil {
+		t.Errorf("parse admin token: %v", err)
+	}
+	if _, err := jwt.ParseAdminToken(userToken); err == nil {
+		t.Error("user token accepted as admin token")
+	}
+	if _, err := jwt.ParseAccessToken(adminToken); err == nil {
+		t.Error("admin token accepted as user token")
+	}
+}
+
+// TestKeyRotation 切换签名密钥后旧密钥签发的token仍然有效，删除旧密钥后失效
+func TestKeyRotation(t *testing.T) {
+	oldKey := newJwtKey(t, "2024-01", jwt.AlgRS256)
+	newKey := newJwtKey(t, "2024-02", jwt.AlgEdDSA)
+	useJwtKeys(t, oldKey.Kid, oldKey)
+	oldToken, err := jwt.GetAccessToken(1, "100")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	useJwtKeys(t, newKey.Kid, oldKey, newKey)
+	if _, err := jwt.ParseAccessToken(oldToken); err != nil {
+		t.Errorf("token signed by previous key rejected: %v", err)
+	}
+	newToken, err := jwt.GetAccessToken(1, "100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := jwt.JWKS()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys[0].Kid != oldKey.Kid || keys[0].Kty != "RSA" || keys[1].Kty != "OKP" {
+		t.Errorf("jwks=%+v", keys)
+	}
+
+	//网关只配置新密钥的公钥，可以验证新token但不能签发，旧密钥的token失效
+	useJwtKeys(t, "", publicJwtKey(t, newKey))
+	if _, err := jwt.ParseAccessToken(newToken); err != nil {
+		t.Errorf("parse with public key: %v", err)
+	}
+	if _, err := jwt.GetAccessToken(1, "100"); err == nil {
+		t.Error("signed without signing kid")
+	}
+	if _, err := jwt.ParseAccessToken(oldToken); err == nil {
+		t.Error("token signed by removed key accepted")
+	}
+}
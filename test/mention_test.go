@@ -0,0 +1,34 @@
+package test
+
+import (
+	"reflect"
+	"star/app/utils/mention"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestParseMention(t *testing.T) {
+	usernames := mention.Parse("@张三 你好，@bob,@张三 a@b.com @1abc @alice_1")
+	if !reflect.DeepEqual(usernames, []string{"张三", "bob", "alice_1"}) {
+		t.Fatalf("unexpected usernames: %v", usernames)
+	}
+
+	var content strings.Builder
+	for i := 0; i < mention.MaxMentions*2; i++ {
+		content.WriteString(" @user" + strconv.Itoa(i))
+	}
+	if n := len(mention.Parse(content.String())); n != mention.MaxMentions {
+		t.Fatalf("expected %d mentions, got %d", mention.MaxMentions, n)
+	}
+}
+
+func TestExcerpt(t *testing.T) {
+	if got := mention.Excerpt("你好世界", 4); got != "你好世界" {
+		t.Fatalf("unexpected excerpt: %q", got)
+	}
+	got := mention.Excerpt(strings.Repeat("赞", 60), 50)
+	if n := len([]rune(got)); n != 50 || !strings.HasSuffix(got, "...") {
+		t.Fatalf("unexpected excerpt: %q", got)
+	}
+}
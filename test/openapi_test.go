@@ -0,0 +1,230 @@
+package test
+
+import (
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"star/app/constant/str"
+	"star/app/gateway/router"
+	"star/proto/admin/adminPb"
+	"star/proto/collect/collectPb"
+	"star/proto/comment/commentPb"
+	"star/proto/community/communityPb"
+	"star/proto/feed/feedPb"
+	"star/proto/like/likePb"
+	"star/proto/message/messagePb"
+	"star/proto/publish/publishPb"
+	"star/proto/relation/relationPb"
+	"star/proto/user/userPb"
+	"strings"
+	"testing"
+)
+
+const apiV1Prefix = "/api/v1"
+
+var ginParam = regexp.MustCompile(`[:*]([^/]+)`)
+
+type openAPIOperation struct {
+	RPC        string                `json:"x-rpc"`
+	RateLimit  string                `json:"x-rate-limit"`
+	Security   []map[string][]string `json:"security"`
+	Parameters []struct {
+		Name     string `json:"name"`
+		In       string `json:"in"`
+		Required bool   `json:"required"`
+	} `json:"parameters"`
+	Responses map[string]interface{} `json:"responses"`
+}
+
+type openAPISpec struct {
+	OpenAPI    string                                  `json:"openapi"`
+	Paths      map[string]map[string]*openAPIOperation `json:"paths"`
+	Components struct {
+		SecuritySchemes map[string]interface{} `json:"securitySchemes"`
+	} `json:"components"`
+}
+
+func loadOpenAPI(t *testing.T, engine *gin.Engine) *openAPISpec {
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, apiV1Prefix+"/openapi.json", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET openapi.json status=%d", w.Code)
+	}
+	spec := new(openAPISpec)
+	if err := json.Unmarshal(w.Body.Bytes(), spec); err != nil {
+		t.Fatalf("decode openapi.json: %v", err)
+	}
+	return spec
+}
+
+// TestOpenAPIMatchesRoutes 注册的/api/v1路由与文档中的接口一一对应
+func TestOpenAPIMatchesRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	engine := router.Setup()
+	spec := loadOpenAPI(t, engine)
+	if !strings.HasPrefix(spec.OpenAPI, "3.") {
+		t.Fatalf("openapi version=%q", spec.OpenAPI)
+	}
+
+	registered := make(map[string]bool)
+	for _, r := range engine.Routes() {
+		if !strings.HasPrefix(r.Path, apiV1Prefix+"/") {
+			continue
+		}
+		path := ginParam.ReplaceAllString(strings.TrimPrefix(r.Path, apiV1Prefix), "{$1}")
+		method := strings.ToLower(r.Method)
+		registered[method+" "+path] = true
+		if spec.Paths[path][method] == nil {
+			t.Errorf("route %s %s is not documented", r.Method, r.Path)
+		}
+	}
+	for path, item := range spec.Paths {
+		for method, op := range item {
+			if !registered[method+" "+path] {
+				t.Errorf("documented %s %s is not registered", method, path)
+			}
+			if len(op.Responses) == 0 {
+				t.Errorf("%s %s has no responses", method, path)
+			}
+			for _, security := range op.Security {
+				for scheme := range security {
+					if _, ok := spec.Components.SecuritySchemes[scheme]; !ok {
+						t.Errorf("%s %s uses undefined security scheme %q", method, path, scheme)
+					}
+				}
+			}
+			declared := make(map[string]bool)
+			for _, p := range op.Parameters {
+				if p.In == "path" {
+					declared[p.Name] = p.Required
+				}
+			}
+			for _, m := range regexp.MustCompile(`\{([^}]+)\}`).FindAllStringSubmatch(path, -1) {
+				if !declared[m[1]] {
+					t.Errorf("%s %s does not declare required path parameter %q", method, path, m[1])
+				}
+			}
+		}
+	}
+}
+
+// TestOpenAPICoversRPCs 每个服务的rpc都有对应的接口，或者被声明为只在服务之间调用
+func TestOpenAPICoversRPCs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	spec := loadOpenAPI(t, router.Setup())
+
+	exposed := make(map[string]bool)
+	for _, item := range spec.Paths {
+		for _, op := range item {
+			if op.RPC != "" {
+				exposed[op.RPC] = true
+			}
+		}
+	}
+	internal := make(map[string]bool)
+	for _, rpc := range router.InternalRPCs() {
+		internal[rpc] = true
+	}
+	files := []protoreflect.FileDescriptor{
+		adminPb.File_admin_proto, collectPb.File_collect_proto, commentPb.File_comment_proto,
+		communityPb.File_community_proto, feedPb.File_feed_proto, likePb.File_like_proto,
+		messagePb.File_message_proto, publishPb.File_publish_proto, relationPb.File_relation_proto,
+		userPb.File_user_proto,
+	}
+	known := make(map[string]bool)
+	for _, file := range files {
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				name := string(methods.Get(j).FullName())
+				known[name] = true
+				if exposed[name] && internal[name] {
+					t.Errorf("rpc %s is both exposed and internal", name)
+				}
+				if !exposed[name] && !internal[name] {
+					t.Errorf("rpc %s is not exposed by the gateway", name)
+				}
+			}
+		}
+	}
+	for rpc := range exposed {
+		if !known[rpc] {
+			t.Errorf("documented rpc %s does not exist", rpc)
+		}
+	}
+}
+
+// TestOpenAPIAuth 需要登录或管理员token的接口在没有token时被中间件拒绝
+func TestOpenAPIAuth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	engine := router.Setup()
+	spec := loadOpenAPI(t, engine)
+
+	for path, item := range spec.Paths {
+		for method, op := range item {
+			//公开接口没有security，可选登录的接口包含一个空的security
+			if !requiresAuth(op.Security) {
+				continue
+			}
+			url := apiV1Prefix + regexp.MustCompile(`\{[^}]+\}`).ReplaceAllString(path, "1")
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, httptest.NewRequest(strings.ToUpper(method), url, nil))
+			var resp struct {
+				StatusCode int `json:"statusCode"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Errorf("%s %s: decode response: %v", method, path, err)
+				continue
+			}
+			if resp.StatusCode != str.NotLoginCode {
+				t.Errorf("%s %s without token: statusCode=%d, want %d", method, path, resp.StatusCode, str.NotLoginCode)
+			}
+		}
+	}
+}
+
+// TestOpenAPIRateLimit 登录、验证码和短信接口有单独的限流策略，所有接口都说明了429响应
+func TestOpenAPIRateLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	spec := loadOpenAPI(t, router.Setup())
+
+	for path, limit := range map[string]string{
+		"/account/login":     "login",
+		"/account/checkCode": "captcha",
+		"/account/send":      "sms",
+		"/account/sendLogin": "sms",
+		"/posts":             "write",
+	} {
+		op := spec.Paths[path]["post"]
+		if op == nil {
+			t.Errorf("POST %s not documented", path)
+			continue
+		}
+		if op.RateLimit != limit {
+			t.Errorf("POST %s x-rate-limit=%q, want %q", path, op.RateLimit, limit)
+		}
+	}
+	for path, item := range spec.Paths {
+		for method, op := range item {
+			if path == "/openapi.json" {
+				continue
+			}
+			if _, ok := op.Responses["429"]; !ok {
+				t.Errorf("%s %s has no 429 response", method, path)
+			}
+		}
+	}
+}
+
+func requiresAuth(security []map[string][]string) bool {
+	for _, requirement := range security {
+		if len(requirement) == 0 {
+			return false
+		}
+	}
+	return len(security) > 0
+}
@@ -0,0 +1,40 @@
+package test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"star/app/constant/settings"
+	"star/app/gateway/router"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TestClientIPTrustedProxies 只有来自可信代理的请求才按X-Forwarded-For识别客户端ip
+func TestClientIPTrustedProxies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	old := settings.Conf.GinConfig
+	t.Cleanup(func() { settings.Conf.GinConfig = old })
+
+	for _, tc := range []struct {
+		proxies []string
+		want    string
+	}{
+		{nil, "10.0.0.1"},
+		{[]string{"10.0.0.0/8"}, "1.2.3.4"},
+	} {
+		settings.Conf.GinConfig = &settings.GinConfig{TrustedProxies: tc.proxies}
+		engine := router.Setup()
+		engine.GET("/test/ip", func(c *gin.Context) {
+			c.String(http.StatusOK, c.ClientIP())
+		})
+		req := httptest.NewRequest(http.MethodGet, "/test/ip", nil)
+		req.RemoteAddr = "10.0.0.1:1234"
+		req.Header.Set("X-Forwarded-For", "1.2.3.4")
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+		if w.Body.String() != tc.want {
+			t.Errorf("trusted proxies %v: ClientIP=%q, want %q", tc.proxies, w.Body.String(), tc.want)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package test
+
+import (
+	"star/app/models"
+	"star/app/utils/rbac"
+	"testing"
+)
+
+// TestRBACCheck 全局角色在所有社区有效，社区角色只在授予的社区有效
+func TestRBACCheck(t *testing.T) {
+	perms := []*models.RolePermission{
+		{RoleCode: models.RoleSuperAdmin, PermissionCode: models.PermAll},
+		{RoleCode: models.RoleAdmin, PermissionCode: models.PermCategoryManage},
+		{RoleCode: models.RoleAdmin, PermissionCode: models.PermPostDelete},
+		{RoleCode: models.RoleCommunityModerator, PermissionCode: models.PermPostDelete},
+	}
+	superAdmin := []*models.UserRole{{RoleCode: models.RoleSuperAdmin}}
+	admin := []*models.UserRole{{RoleCode: models.RoleAdmin}}
+	moderator := []*models.UserRole{{RoleCode: models.RoleCommunityModerator, CommunityId: 10}}
+
+	cases := []struct {
+		name        string
+		roles       []*models.UserRole
+		permission  string
+		communityId int64
+		want        bool
+	}{
+		{"super admin has every permission", superAdmin, models.PermRoleGrant, 0, true},
+		{"admin global permission", admin, models.PermCategoryManage, 0, true},
+		{"admin global role applies to communities", admin, models.PermPostDelete, 10, true},
+		{"admin lacks permission", admin, models.PermRoleGrant, 0, false},
+		{"moderator in own community", moderator, models.PermPostDelete, 10, true},
+		{"moderator in other community", moderator, models.PermPostDelete, 11, false},
+		{"moderator role is not global", moderator, models.PermPostDelete, 0, false},
+		{"no roles", nil, models.PermPostDelete, 10, false},
+	}
+	for _, c := range cases {
+		if got := rbac.Check(c.roles, perms, c.permission, c.communityId); got != c.want {
+			t.Errorf("%s: Check=%v, want %v", c.name, got, c.want)
+		}
+	}
+}